@@ -2,122 +2,243 @@ package main
 
 import (
 	//gen "github.com/asm-jaime/gen"
-	"log"
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/contrib/sessions"
 	//"github.com/gin-gonic/contrib/static"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
 // ========== user
 
 func getUsers(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		req, err := mongo.getUsers()
+		req, err := mongo.getUsers(c.Request.Context())
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
-			c.JSON(http.StatusOK,
-				gin.H{"msg": "get points complete", "body": req})
+			v := viewerFrom(c.Request.Context())
+			users := make([]legacyUser, len(req))
+			for i := range req {
+				v.redact(&req[i])
+				users[i] = legacyUser(req[i])
+			}
+			jsonCached(c, "get points complete", users)
 		}
 	}
 }
 
 func getUser(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req geoUser
-		err := c.Bind(&req)
+		var legacy legacyUser
+		err := c.ShouldBind(&legacy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
-		req, err = mongo.getUser(&req)
+		req, err := mongo.getUser(c.Request.Context(), (*geoUser)(&legacy))
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
+			viewerFrom(c.Request.Context()).redact(&req)
 			c.JSON(http.StatusOK,
-				gin.H{"msg": "get user complete", "body": req})
+				gin.H{"msg": "get user complete", "body": legacyUser(req)})
 		}
 	}
 }
 
 func postUser(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		mongo, ok := c.Keys["mongo"].(*mongoDB)
-		if !ok {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": "can't connect to db", "body": nil})
-		}
-		var req geoUser
-		err := c.Bind(&req)
+		var legacy legacyUser
+		err := c.ShouldBind(&legacy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
+		req := geoUser(legacy)
 
-		err = mongo.postUser(&req)
+		err = mongo.postUser(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"msg": "post user complete", "body": req})
+		c.JSON(http.StatusOK, gin.H{"msg": "post user complete", "body": legacyUser(req)})
 	}
 }
 
 func putUser(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req geoUser
+		var legacy legacyUser
+
+		err := c.ShouldBind(&legacy)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		req := geoUser(legacy)
 
-		err := c.Bind(&req)
+		err = applyIfMatch(c, &req.Version)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
 
 		if req.ID.Hex() != "" {
-			err = mongo.updateUser(&req)
+			err = mongo.updateUser(c.Request.Context(), &req)
 			if err != nil {
-				c.JSON(http.StatusInternalServerError,
-					gin.H{"msg": err.Error(), "body": nil})
+				abortError(c, err)
 				return
 			}
 		} else {
-			log.Println("no id and post user: ", req)
-			err = mongo.postUser(&req)
+			requestLogger(c).Debug("no id, post user", "email", req.Email)
+			err = mongo.postUser(c.Request.Context(), &req)
 			if err != nil {
-				c.JSON(http.StatusInternalServerError,
-					gin.H{"msg": err.Error(), "body": nil})
+				abortError(c, err)
 				return
 			}
 		}
+		viewerFrom(c.Request.Context()).redact(&req)
 
-		c.JSON(http.StatusOK, gin.H{"msg": "post user complete", "body": req})
+		c.JSON(http.StatusOK, gin.H{"msg": "post user complete", "body": legacyUser(req)})
 	}
 }
 
 func delUser(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		var legacy legacyUser
+		err := c.ShouldBind(&legacy)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		req := geoUser(legacy)
+
+		err = mongo.delUser(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "del user complete", "body": legacyUser(req)})
+	}
+}
+
+func getUserByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		req, err := mongo.getUser(c.Request.Context(), &geoUser{ID: id})
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		viewerFrom(c.Request.Context()).redact(&req)
+
+		jsonVersioned(c, req.Version, "get user complete", req)
+	}
+}
+
+func putUserByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
 		var req geoUser
-		err := c.Bind(&req)
+		err = c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		req.ID = id
+		err = applyIfMatch(c, &req.Version)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		err = mongo.updateUser(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		viewerFrom(c.Request.Context()).redact(&req)
+
+		c.Header("ETag", versionETag(req.Version))
+		c.JSON(http.StatusOK, gin.H{"msg": "put user complete", "body": req})
+	}
+}
+
+// patchUser applies a JSON Merge Patch, members absent from it are kept
+func patchUser(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		req, err := mongo.getUser(c.Request.Context(), &geoUser{ID: id})
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		// the patch applies to the version read here, or the one in If-Match
+		version := req.Version
+		err = applyIfMatch(c, &version)
+		if err == nil && version != req.Version {
+			err = errVersionMismatch
+		}
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		err = bindMergePatch(c, &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		req.ID = id
+		req.Version = version
+
+		err = mongo.updateUser(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		viewerFrom(c.Request.Context()).redact(&req)
+
+		c.Header("ETag", versionETag(req.Version))
+		c.JSON(http.StatusOK, gin.H{"msg": "patch user complete", "body": req})
+	}
+}
+
+func delUserByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
 
-		err = mongo.delUser(&req)
+		req := geoUser{ID: id}
+		err = mongo.delUser(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
 
@@ -129,119 +250,223 @@ func delUser(mongo *mongoDB) gin.HandlerFunc {
 
 func getEvents(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		req, err := mongo.getEvents()
+		req, err := mongo.getEvents(c.Request.Context())
 		if err != nil {
-			c.JSON(http.StatusNotFound,
-				gin.H{"msg": "events not found", "body": nil})
+			abortError(c, err)
 		} else {
-			c.JSON(http.StatusOK,
-				gin.H{"msg": "get events successful complete", "body": req})
+			events := make([]legacyEvent, len(req))
+			for i := range req {
+				events[i] = legacyEvent(req[i])
+			}
+			jsonCached(c, "get events successful complete", events)
 		}
 	}
 }
 
 func getEvent(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req geoEvent
-		err := c.Bind(&req)
+		var legacy legacyEvent
+		err := c.ShouldBind(&legacy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
-		req, err = mongo.getEvent(&req)
+		req, err := mongo.getEvent(c.Request.Context(), (*geoEvent)(&legacy))
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
 			c.JSON(http.StatusOK,
-				gin.H{"msg": "get event complete", "body": req})
+				gin.H{"msg": "get event complete", "body": legacyEvent(req)})
 		}
 	}
 }
 
 func postEvent(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		mongo, ok := c.Keys["mongo"].(*mongoDB)
-		if !ok {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": "can't connect to db", "body": nil})
-		}
-
-		var req geoEvent
-		err := c.Bind(&req)
+		var legacy legacyEvent
+		err := c.ShouldBind(&legacy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
+		req := geoEvent(legacy)
 
-		err = mongo.postEvent(&req)
+		err = mongo.postEvent(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
 			c.JSON(http.StatusOK,
-				gin.H{"msg": "post event complete", "body": req})
+				gin.H{"msg": "post event complete", "body": legacyEvent(req)})
 		}
 	}
 }
 
 func putEvent(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req geoEvent
-		err := c.Bind(&req)
+		var legacy legacyEvent
+		err := c.ShouldBind(&legacy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		req := geoEvent(legacy)
+
+		err = applyIfMatch(c, &req.Version)
+		if err != nil {
+			abortError(c, err)
 			return
 		}
 
 		if req.ID.Hex() != "" {
-			err = mongo.updateEvent(&req)
+			err = mongo.updateEvent(c.Request.Context(), &req)
 			if err != nil {
-				c.JSON(http.StatusInternalServerError,
-					gin.H{"msg": err.Error(), "body": nil})
+				abortError(c, err)
 				return
 			}
 		} else {
-			err = mongo.postEvent(&req)
+			err = mongo.postEvent(c.Request.Context(), &req)
 			if err != nil {
-				c.JSON(http.StatusInternalServerError,
-					gin.H{"msg": err.Error(), "body": nil})
+				abortError(c, err)
 				return
 			}
 		}
 
 		c.JSON(http.StatusOK,
-			gin.H{"msg": "put event complete", "body": req})
+			gin.H{"msg": "put event complete", "body": legacyEvent(req)})
 	}
 }
 
 func delEvent(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		mongo, ok := c.Keys["mongo"].(*mongoDB)
-		if !ok {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": "can't connect to db", "body": nil})
-		}
-		var req geoEvent
-		err := c.Bind(&req)
+		var legacy legacyEvent
+		err := c.ShouldBind(&legacy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
-		err = mongo.delEvent(&req)
+		err = mongo.delEvent(c.Request.Context(), (*geoEvent)(&legacy))
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
 			c.JSON(http.StatusOK,
-				gin.H{"msg": "del event complete", "body": req})
+				gin.H{"msg": "del event complete", "body": legacy})
+		}
+	}
+}
+
+func getEventByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		req, err := mongo.getEvent(c.Request.Context(), &geoEvent{ID: id})
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		jsonVersioned(c, req.Version, "get event complete", req)
+	}
+}
+
+func putEventByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		var req geoEvent
+		err = c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		req.ID = id
+		err = applyIfMatch(c, &req.Version)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		err = mongo.updateEvent(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.Header("ETag", versionETag(req.Version))
+		c.JSON(http.StatusOK, gin.H{"msg": "put event complete", "body": req})
+	}
+}
+
+// patchEvent applies a JSON Merge Patch, members absent from it are kept
+func patchEvent(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		req, err := mongo.getEvent(c.Request.Context(), &geoEvent{ID: id})
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		// the patch applies to the version read here, or the one in If-Match
+		version := req.Version
+		err = applyIfMatch(c, &version)
+		if err == nil && version != req.Version {
+			err = errVersionMismatch
+		}
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		err = bindMergePatch(c, &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		req.ID = id
+		req.Version = version
+
+		err = mongo.updateEvent(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
 		}
+
+		c.Header("ETag", versionETag(req.Version))
+		c.JSON(http.StatusOK, gin.H{"msg": "patch event complete", "body": req})
+	}
+}
+
+func delEventByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		req := geoEvent{ID: id}
+		err = mongo.delEvent(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "del event complete", "body": req})
 	}
 }
 
@@ -249,13 +474,11 @@ func delEvent(mongo *mongoDB) gin.HandlerFunc {
 
 func getLocs(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		req, err := mongo.getLocs()
+		req, err := mongo.getLocs(c.Request.Context())
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
-			c.JSON(http.StatusOK,
-				gin.H{"msg": "get points complete", "body": req})
+			jsonCached(c, "get points complete", req)
 		}
 	}
 }
@@ -263,20 +486,17 @@ func getLocs(mongo *mongoDB) gin.HandlerFunc {
 func getLoc(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req geoLocation
-		err := c.Bind(&req)
+		err := c.ShouldBind(&req)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
-		req, err = mongo.getLoc(&req)
+		req, err = mongo.getLoc(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
-			c.JSON(http.StatusOK,
-				gin.H{"msg": "get points complete", "body": req})
+			jsonCached(c, "get points complete", req)
 		}
 	}
 }
@@ -284,16 +504,15 @@ func getLoc(mongo *mongoDB) gin.HandlerFunc {
 func postLoc(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req geoLocation
-		err := c.Bind(&req)
+		err := c.ShouldBind(&req)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
-		point, err := mongo.postLoc(&req)
+		point, err := mongo.postLoc(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
 			c.JSON(http.StatusOK,
 				gin.H{"msg": "post point complete", "body": point})
@@ -304,28 +523,29 @@ func postLoc(mongo *mongoDB) gin.HandlerFunc {
 func putLoc(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req geoLocation
-		err := c.Bind(&req)
+		err := c.ShouldBind(&req)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
+		err = applyIfMatch(c, &req.Version)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		// an unknown id is a 404, a post would answer under a new one
 		if req.ID.Hex() != "" {
-			err = mongo.updateLoc(&req)
+			err = mongo.updateLoc(c.Request.Context(), &req)
 			if err != nil {
-				point, err := mongo.postLoc(&req)
-				if err != nil {
-					c.JSON(http.StatusInternalServerError,
-						gin.H{"msg": err.Error(), "body": point})
-					return
-				}
+				abortError(c, err)
+				return
 			}
 		} else {
-			_, err := mongo.postLoc(&req)
+			_, err := mongo.postLoc(c.Request.Context(), &req)
 			if err != nil {
-				c.JSON(http.StatusInternalServerError,
-					gin.H{"msg": err.Error(), "body": nil})
+				abortError(c, err)
 				return
 			}
 		}
@@ -337,17 +557,138 @@ func putLoc(mongo *mongoDB) gin.HandlerFunc {
 func delLoc(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req geoLocation
-		err := c.Bind(&req)
+		err := c.ShouldBind(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		err = mongo.delLoc(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "del point complete", "body": req})
+	}
+}
+
+func getLocByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		req, err := mongo.getLoc(c.Request.Context(), &geoLocation{ID: id})
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		jsonVersioned(c, req.Version, "get point complete", req)
+	}
+}
+
+func putLocByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		var req geoLocation
+		err = c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		req.ID = id
+		err = applyIfMatch(c, &req.Version)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		err = mongo.updateLoc(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.Header("ETag", versionETag(req.Version))
+		c.JSON(http.StatusOK, gin.H{"msg": "put point complete", "body": req})
+	}
+}
+
+// patchLoc applies a JSON Merge Patch, members absent from it are kept
+func patchLoc(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		// the patch applies to the stored point, if the viewer can see it
+		req, err := mongo.readLoc(c.Request.Context(), &geoLocation{ID: id})
+		if err == nil {
+			_, err = mongo.viewLoc(c.Request.Context(), req)
+		}
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		// the patch applies to the version read here, or the one in If-Match
+		version := req.Version
+		err = applyIfMatch(c, &version)
+		if err == nil && version != req.Version {
+			err = errVersionMismatch
+		}
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		err = bindMergePatch(c, &req)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
+		req.ID = id
+		req.Version = version
 
-		err = mongo.delLoc(&req)
+		err = mongo.updateLoc(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		body, err := mongo.viewLoc(c.Request.Context(), req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
+			return
+		}
+
+		c.Header("ETag", versionETag(req.Version))
+		c.JSON(http.StatusOK, gin.H{"msg": "patch point complete", "body": body})
+	}
+}
+
+func delLocByID(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		req := geoLocation{ID: id}
+		err = mongo.delLoc(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
 			return
 		}
 
@@ -359,18 +700,17 @@ func delLoc(mongo *mongoDB) gin.HandlerFunc {
 
 func postGeoEvent(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req reqGeoEvent
-		err := c.Bind(&req)
+		var legacy legacyGeoEvent
+		err := c.ShouldBind(&legacy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
+		req := reqGeoEvent{GeoLoc: legacy.GeoLoc, Event: geoEvent(legacy.Event)}
 
-		res, err := mongo.postGeoEvent(&req)
+		res, err := mongo.postGeoEvent(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
 
@@ -383,29 +723,26 @@ func postGeoEvent(mongo *mongoDB) gin.HandlerFunc {
 func getDistance(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req geoLocation
-		err := c.BindJSON(&req)
+		err := c.ShouldBindJSON(&req)
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
 		session := sessions.Default(c)
 		user := geoUser{}
 		user.Email = session.Get("user-id").(string)
-		user, err = mongo.getUser(&user)
+		user, err = mongo.getUser(c.Request.Context(), &user)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
 
 		point := geoLocation{}
 		point.ID = user.ID
-		point, err = mongo.getLoc(&point)
+		point, err = mongo.readLoc(c.Request.Context(), &point)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 			return
 		}
 
@@ -421,21 +758,27 @@ func getDistance(mongo *mongoDB) gin.HandlerFunc {
 func getNearLoc(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req reqNear
-		err := c.BindJSON(&req)
-
+		var err error
+		// a json body on GET is the legacy form of this route
+		if c.Request.ContentLength > 0 {
+			c.Header("Deprecation", "true")
+			err = c.ShouldBindJSON(&req)
+		} else {
+			err = c.ShouldBindQuery(&req)
+		}
 		if err != nil {
-			c.JSON(http.StatusBadRequest,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
+		if req.TGeos == "" {
+			req.TGeos = "Point"
+		}
 
-		locs, err := mongo.getNearLoc(&req)
+		locs, err := mongo.getNearLoc(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
-			c.JSON(http.StatusOK,
-				gin.H{"msg": "get points complete", "body": locs})
+			jsonCached(c, "get points complete", locs)
 		}
 	}
 }
@@ -445,22 +788,140 @@ func getNearLoc(mongo *mongoDB) gin.HandlerFunc {
 func getFiltered(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req reqFilter
-		err := c.Bind(&req)
-		// fmt.Println(req)
+		err := c.ShouldBind(&req)
 
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, errValidation("invalid_request", err))
 			return
 		}
 
-		elocs, err := mongo.getFiltered(&req)
-		// fmt.Println(elocs)
+		elocs, err := mongo.getFiltered(c.Request.Context(), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError,
-				gin.H{"msg": err.Error(), "body": nil})
+			abortError(c, err)
 		} else {
-			c.JSON(http.StatusOK,
-				gin.H{"msg": "get filtered event-loc complete", "body": elocs})
+			jsonCached(c, "get filtered event-loc complete", elocs)
+		}
+	}
+}
+
+// ========== bulk
+
+// bindBulk binds a reqBulk and decodes each item into items[i] via
+// newItem, invalid items get their problem in results and are left out of
+// the returned indexes
+func bindBulk(c *gin.Context, maxItems int, newItem func() interface{}) (
+	req reqBulk, items []interface{}, results []bulkItemResult, err error) {
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		return req, nil, nil, errValidation("invalid_request", err)
+	}
+	if len(req.Items) > maxItems {
+		ae := newAPIError(kindValidation, "too_many_items",
+			fmt.Sprintf("a bulk takes at most %d items", maxItems), nil)
+		ae.Fields = []fieldError{{Field: "items", Rule: "max",
+			Message: fmt.Sprintf("must be at most %d items", maxItems)}}
+		return req, nil, nil, ae
+	}
+
+	items = make([]interface{}, len(req.Items))
+	results = make([]bulkItemResult, len(req.Items))
+	for i, raw := range req.Items {
+		results[i].Index = i
+		item := newItem()
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		if binding.EnableDecoderDisallowUnknownFields {
+			decoder.DisallowUnknownFields()
+		}
+		err := decoder.Decode(item)
+		if err == nil {
+			err = binding.Validator.ValidateStruct(item)
+		}
+		if err != nil {
+			prob := newProblem(c, errValidation("invalid_item", err))
+			results[i].Error = &prob
+			continue
+		}
+		items[i] = item
+	}
+	return req, items, results, nil
+}
+
+// answerBulk is 200 when every item was written, else 207 with the problems
+func answerBulk(c *gin.Context, msg string, results []bulkItemResult) {
+	status := http.StatusOK
+	for _, res := range results {
+		if res.Error != nil {
+			status = http.StatusMultiStatus
+			break
+		}
+	}
+	c.JSON(status, gin.H{"msg": msg, "body": results})
+}
+
+func postEventsBulk(mongo *mongoDB, maxItems int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, items, results, err := bindBulk(c, maxItems,
+			func() interface{} { return &geoEvent{} })
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		events, index := []geoEvent{}, []int{}
+		for i, item := range items {
+			if item != nil {
+				events = append(events, *item.(*geoEvent))
+				index = append(index, i)
+			}
+		}
+		errs, err := mongo.postEvents(c.Request.Context(), events, req.Upsert)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		for j, i := range index {
+			results[i].ExternalID = events[j].ExternalID
+			if errs[j] != nil {
+				prob := newProblem(c, toAPIError(errs[j]))
+				results[i].Error = &prob
+			} else {
+				results[i].ID = events[j].ID
+			}
+		}
+		answerBulk(c, "bulk events complete", results)
+	}
+}
+
+func postLocsBulk(mongo *mongoDB, maxItems int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, items, results, err := bindBulk(c, maxItems,
+			func() interface{} { return &geoLocation{} })
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		locs, index := []geoLocation{}, []int{}
+		for i, item := range items {
+			if item != nil {
+				locs = append(locs, *item.(*geoLocation))
+				index = append(index, i)
+			}
+		}
+		errs, err := mongo.postLocs(c.Request.Context(), locs, req.Upsert)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		for j, i := range index {
+			results[i].ExternalID = locs[j].ExternalID
+			if errs[j] != nil {
+				prob := newProblem(c, toAPIError(errs[j]))
+				results[i].Error = &prob
+			} else {
+				results[i].ID = locs[j].ID
+			}
 		}
+		answerBulk(c, "bulk points complete", results)
 	}
 }
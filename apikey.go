@@ -0,0 +1,496 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== api keys
+
+// apiScopes a key can hold, admin includes all the others
+var apiScopes = []string{
+	"read:users", "write:users",
+	"read:events", "write:events",
+	"read:locs", "write:locs",
+	"admin",
+}
+
+// keyPrefix marks the keys of this service, so they are easy to spot in
+// logs and secret scanners
+const keyPrefix = "gk_"
+
+var (
+	errKeyRequired = errUnauthorized("key_required", "this route needs an "+apiKeyHeader+" header")
+	errKeyInvalid  = errUnauthorized("key_invalid", "api key is unknown or revoked")
+	errKeyExpired  = errUnauthorized("key_expired", "api key is expired")
+	errKeyIP       = errForbidden("key_ip_denied", "api key is not allowed from this address")
+	errSuspended   = errForbidden("user_suspended", "the owner of the api key is suspended")
+)
+
+// newKeyMaterial returns a key as the client sees it with its public prefix
+// and hash, the key is gk_<prefix>_<secret>
+func newKeyMaterial() (key, prefix, hash string, err error) {
+	buf := make([]byte, 6+32)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+	prefix = hex.EncodeToString(buf[:6])
+	key = keyPrefix + prefix + "_" + base64.RawURLEncoding.EncodeToString(buf[6:])
+	return key, prefix, hashKey(key), nil
+}
+
+// hashKey is a plain sha256, the keys are random so there is nothing for a
+// slow hash to protect
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// parseKey returns the prefix of a well formed key
+func parseKey(key string) (prefix string, ok bool) {
+	rest := strings.TrimPrefix(key, keyPrefix)
+	if len(rest) == len(key) {
+		return "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(prefix) != 12 || secret == "" {
+		return "", false
+	}
+	return prefix, true
+}
+
+func (k *apiKey) hasScope(scope string) bool {
+	return contains(k.Scopes, scope) || contains(k.Scopes, "admin")
+}
+
+// allowIP matches ip against the allowlist of ips and cidrs, an empty one
+// allows any address
+func (k *apiKey) allowIP(ip string) bool {
+	if len(k.AllowIPs) == 0 {
+		return true
+	}
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, allow := range k.AllowIPs {
+		if _, network, err := net.ParseCIDR(allow); err == nil {
+			if network.Contains(addr) {
+				return true
+			}
+		} else if addr.Equal(net.ParseIP(allow)) {
+			return true
+		}
+	}
+	return false
+}
+
+// createKey stores a new key for req, the answer holds the key itself
+func createKey(ctx context.Context, mongo *mongoDB, req *reqAPIKey, now time.Time) (res apiKeySecret, err error) {
+	if !req.ExpiresAt.IsZero() && !req.ExpiresAt.After(now) {
+		ae := newAPIError(kindValidation, "invalid_request", "request is invalid", nil)
+		ae.Fields = []fieldError{{Field: "expires_at", Rule: "future", Message: "must be in the future"}}
+		return res, ae
+	}
+
+	var owner geoUser
+	if req.UserID != "" {
+		owner, err = mongo.getUser(ctx, &geoUser{ID: bson.ObjectIdHex(req.UserID)})
+		if err == mgo.ErrNotFound {
+			ae := newAPIError(kindValidation, "invalid_request", "request is invalid", nil)
+			ae.Fields = []fieldError{{Field: "user_id", Rule: "exists", Message: "must be an existing user"}}
+			return res, ae
+		} else if err != nil {
+			return res, err
+		}
+	}
+
+	key, prefix, hash, err := newKeyMaterial()
+	if err != nil {
+		return res, err
+	}
+	res.apiKey = apiKey{
+		ID:        bson.NewObjectId(),
+		Name:      req.Name,
+		UserID:    owner.ID,
+		Prefix:    prefix,
+		Hash:      hash,
+		Scopes:    req.Scopes,
+		AllowIPs:  req.AllowIPs,
+		ExpiresAt: req.ExpiresAt,
+		CreatedAt: now,
+	}
+	res.Key = key
+	err = mongo.postKey(ctx, &res.apiKey)
+	return res, err
+}
+
+// rotateKey gives a key new material, the old one stops working at once
+func rotateKey(ctx context.Context, mongo *mongoDB, id bson.ObjectId, now time.Time) (res apiKeySecret, err error) {
+	key, prefix, hash, err := newKeyMaterial()
+	if err != nil {
+		return res, err
+	}
+	res.apiKey, err = mongo.rotateKey(ctx, id, prefix, hash, now)
+	res.Key = key
+	return res, err
+}
+
+// ========== keyring
+
+type cachedKey struct {
+	key     apiKey
+	owner   geoUser
+	fetched time.Time
+}
+
+// keyring finds the key of a request, keys are cached for ttl and last use
+// is written at most once per touchEvery
+type keyring struct {
+	find  func(ctx context.Context, prefix string) (apiKey, error)
+	owner func(ctx context.Context, u *geoUser) (geoUser, error)
+	touch func(ctx context.Context, id bson.ObjectId, at time.Time, ip string) error
+
+	ttl        time.Duration
+	touchEvery time.Duration
+
+	mu      sync.Mutex
+	cache   map[string]cachedKey
+	touched map[bson.ObjectId]time.Time
+}
+
+func newKeyring(mongo *mongoDB, conf *authConfig) *keyring {
+	return &keyring{
+		find:       mongo.getKeyByPrefix,
+		owner:      mongo.getUser,
+		touch:      mongo.touchKey,
+		ttl:        conf.CacheTTL,
+		touchEvery: conf.TouchInterval,
+		cache:      map[string]cachedKey{},
+		touched:    map[bson.ObjectId]time.Time{},
+	}
+}
+
+// lookup checks the key of a request and reads its owner, unknown and
+// revoked keys look the same
+func (r *keyring) lookup(ctx context.Context, raw string, now time.Time) (key apiKey, owner geoUser, err error) {
+	prefix, ok := parseKey(raw)
+	if !ok {
+		return key, owner, errKeyInvalid
+	}
+
+	r.mu.Lock()
+	cached, ok := r.cache[prefix]
+	r.mu.Unlock()
+	if ok && now.Sub(cached.fetched) < r.ttl {
+		key, owner = cached.key, cached.owner
+	} else {
+		key, err = r.find(ctx, prefix)
+		if err == nil && key.UserID != "" {
+			owner, err = r.owner(ctx, &geoUser{ID: key.UserID})
+		}
+		if err == mgo.ErrNotFound {
+			return key, owner, errKeyInvalid
+		} else if err != nil {
+			return key, owner, err
+		}
+		// only found keys are cached, random prefixes can't grow the map
+		r.mu.Lock()
+		r.cache[prefix] = cachedKey{key: key, owner: owner, fetched: now}
+		r.mu.Unlock()
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashKey(raw)), []byte(key.Hash)) != 1 ||
+		!key.RevokedAt.IsZero() {
+		return key, owner, errKeyInvalid
+	}
+	if !key.ExpiresAt.IsZero() && !now.Before(key.ExpiresAt) {
+		return key, owner, errKeyExpired
+	}
+	if owner.Moderation != nil && owner.Moderation.Suspended {
+		return key, owner, errSuspended
+	}
+	return key, owner, nil
+}
+
+// forget drops the cached keys with id or owned by id after a change to
+// them, other instances see it once their entry is older than ttl
+func (r *keyring) forget(id bson.ObjectId) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for prefix, cached := range r.cache {
+		if cached.key.ID == id || cached.key.UserID == id {
+			delete(r.cache, prefix)
+		}
+	}
+}
+
+// keyRole is the role a key acts with, keys with the admin scope are
+// service accounts and act as admin
+func keyRole(key *apiKey, owner *geoUser) string {
+	if contains(key.Scopes, "admin") {
+		return "admin"
+	}
+	return owner.Role
+}
+
+// used records the last use of a key in the background
+func (r *keyring) used(key *apiKey, ip string, now time.Time) {
+	r.mu.Lock()
+	last := r.touched[key.ID]
+	due := now.Sub(last) >= r.touchEvery
+	if due {
+		r.touched[key.ID] = now
+	}
+	r.mu.Unlock()
+	if !due {
+		return
+	}
+
+	go func(id bson.ObjectId) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := r.touch(ctx, id, now, ip); err != nil {
+			slog.Warn("api key last use", "key_id", id.Hex(), "error", err.Error())
+		}
+	}(key.ID)
+}
+
+// authenticate is lookup that also checks the ip and the scopes a request
+// needs, the key is marked used when it passes. It is shared by the REST
+// and grpc apis.
+func (r *keyring) authenticate(ctx context.Context, raw, ip string, scopes []string, now time.Time) (key apiKey, owner geoUser, err error) {
+	key, owner, err = r.lookup(ctx, raw, now)
+	if err != nil {
+		return key, owner, err
+	}
+	if !key.allowIP(ip) {
+		return key, owner, errKeyIP
+	}
+	for _, scope := range scopes {
+		if !key.hasScope(scope) {
+			return key, owner, errForbidden("scope_missing", "api key lacks the "+scope+" scope")
+		}
+	}
+	r.used(&key, ip, now)
+	return key, owner, nil
+}
+
+// ========== key middleware
+
+// routeScopes is what a key needs for a route, the group after /api/v1 is
+// the resource and GET reads it. The admin group is guarded by roles.
+func routeScopes(method, route string) []string {
+	resource, _, _ := strings.Cut(strings.TrimPrefix(route, "/api/v1/"), "/")
+	switch {
+	case resource == "admin":
+		return nil
+	case resource == "graphql":
+		return []string{"read:users", "read:events", "read:locs"}
+	case route == "/api/v1/locs/geoevent":
+		return []string{"write:events", "write:locs"}
+	}
+	action := "write:"
+	if method == http.MethodGet || method == http.MethodHead {
+		action = "read:"
+	}
+	return []string{action + resource}
+}
+
+// middlewareAPIKey authenticates the X-API-Key of a request and checks the
+// scopes of the route. Requests without a key stay anonymous unless the
+// config requires keys, the admin routes always do. The key id, its owner
+// and role are set for the handlers. A key that fails takes a token of the
+// ip's budget in limits first, so guessing keys is throttled.
+func middlewareAPIKey(conf *authConfig, ring *keyring, limits *rateLimitConfig, store limitStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		scopes := routeScopes(c.Request.Method, route)
+		raw := c.GetHeader(apiKeyHeader)
+		if raw == "" {
+			if conf.Required || strings.HasPrefix(route, "/api/v1/admin/") {
+				abortError(c, errKeyRequired)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		key, owner, err := ring.authenticate(c.Request.Context(), raw, c.ClientIP(), scopes, time.Now())
+		if err != nil {
+			if limitRequest(c, limits, store, limitIdentity("", "", c.ClientIP())) {
+				abortError(c, err)
+			}
+			return
+		}
+
+		c.Set("key_id", key.ID.Hex())
+		if owner.ID != "" {
+			c.Set("user_id", owner.ID.Hex())
+		}
+		c.Set("role", keyRole(&key, &owner))
+		c.Next()
+	}
+}
+
+// ========== key admin api
+
+func getKeys(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keys, err := mongo.getKeys(c.Request.Context())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get keys complete", "body": keys})
+	}
+}
+
+func postKey(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req reqAPIKey
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		res, err := createKey(c.Request.Context(), mongo, &req, time.Now())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "post key complete", "body": res})
+	}
+}
+
+func postKeyRotate(mongo *mongoDB, ring *keyring) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		res, err := rotateKey(c.Request.Context(), mongo, id, time.Now())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		ring.forget(id)
+
+		c.JSON(http.StatusOK, gin.H{"msg": "rotate key complete", "body": res})
+	}
+}
+
+func delKey(mongo *mongoDB, ring *keyring) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		key, err := mongo.revokeKey(c.Request.Context(), id, time.Now())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		ring.forget(id)
+
+		c.JSON(http.StatusOK, gin.H{"msg": "revoke key complete", "body": key})
+	}
+}
+
+// ========== key cli
+
+const keysUsage = `usage: geoloc -start keys <command> [flags]
+  list
+  create -name partner -scopes read:locs,write:events [-user <user id>] [-allow-ips 10.0.0.0/8] [-ttl 2160h]
+  rotate -id <key id>
+  revoke -id <key id>`
+
+// keysCommand manages api keys from the shell, the key of create and rotate
+// is printed once as json
+func keysCommand(conf *config, args []string, out io.Writer) (err error) {
+	if len(args) == 0 {
+		return errors.New(keysUsage)
+	}
+	fs := flag.NewFlagSet("keys "+args[0], flag.ContinueOnError)
+	name := fs.String("name", "", "key name")
+	user := fs.String("user", "", "id of the user the key acts as")
+	scopes := fs.String("scopes", "", "comma separated scopes: "+strings.Join(apiScopes, ", "))
+	allowIPs := fs.String("allow-ips", "", "comma separated ips or cidrs, empty allows any")
+	ttl := fs.Duration("ttl", 0, "key lifetime, 0 never expires")
+	id := fs.String("id", "", "key id")
+	err = fs.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+
+	setupValidation(false)
+	mongo := mongoDB{}
+	err = mongo.setConfig(&conf.Mongo)
+	if err != nil {
+		return err
+	}
+	defer mongo.Session.Close()
+	ctx := context.Background()
+	now := time.Now()
+
+	var res interface{}
+	switch args[0] {
+	case "list":
+		res, err = mongo.getKeys(ctx)
+	case "create":
+		req := reqAPIKey{Name: *name, UserID: *user, Scopes: splitList(*scopes), AllowIPs: splitList(*allowIPs)}
+		if *ttl > 0 {
+			req.ExpiresAt = now.Add(*ttl)
+		}
+		if err = validate(&req); err != nil {
+			return fmt.Errorf("%v %v", err, toAPIError(err).Fields)
+		}
+		res, err = createKey(ctx, &mongo, &req, now)
+	case "rotate", "revoke":
+		var kid bson.ObjectId
+		kid, err = parseID(*id)
+		if err != nil {
+			return fmt.Errorf("-id: %v", err)
+		}
+		if args[0] == "rotate" {
+			res, err = rotateKey(ctx, &mongo, kid, now)
+		} else {
+			res, err = mongo.revokeKey(ctx, kid, now)
+		}
+	default:
+		return errors.New(keysUsage)
+	}
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestAPIKeyScopes(t *testing.T) {
+	cases := []struct {
+		method, route string
+		scopes        []string
+	}{
+		{"GET", "/api/v1/locs/near", []string{"read:locs"}},
+		{"PATCH", "/api/v1/users/:id", []string{"write:users"}},
+		{"POST", "/api/v1/events/bulk", []string{"write:events"}},
+		{"POST", "/api/v1/locs/geoevent", []string{"write:events", "write:locs"}},
+		{"GET", "/api/v1/admin/keys", nil},
+	}
+	for _, cs := range cases {
+		assert.Equal(t, cs.scopes, routeScopes(cs.method, cs.route), cs.route)
+	}
+
+	key := apiKey{Scopes: []string{"read:locs"}, AllowIPs: []string{"10.0.0.0/8", "192.168.1.7"}}
+	assert.True(t, key.hasScope("read:locs"))
+	assert.False(t, key.hasScope("write:locs"))
+	assert.True(t, key.allowIP("10.1.2.3"))
+	assert.True(t, key.allowIP("192.168.1.7"))
+	assert.False(t, key.allowIP("192.168.1.8"))
+	key.Scopes = []string{"admin"}
+	assert.True(t, key.hasScope("write:locs"))
+
+	setupValidation(false)
+	err := validate(&reqAPIKey{Name: "p", Scopes: []string{"read:all"}, AllowIPs: []string{"10.0.0.0/33"}})
+	fields := []string{}
+	for _, f := range toAPIError(err).Fields {
+		fields = append(fields, f.Field)
+	}
+	assert.ElementsMatch(t, []string{"scopes[0]", "allow_ips[0]"}, fields)
+}
+
+func TestAPIKey(t *testing.T) {
+	now := time.Now()
+	raw, prefix, hash, err := newKeyMaterial()
+	assert.NoError(t, err)
+	p, ok := parseKey(raw)
+	assert.True(t, ok)
+	assert.Equal(t, prefix, p)
+
+	stored := apiKey{ID: bson.NewObjectId(), Prefix: prefix, Hash: hash, Scopes: []string{"read:locs"}}
+	finds := 0
+	touched := make(chan bson.ObjectId, 1)
+	ring := newKeyring(&mongoDB{}, &authConfig{CacheTTL: time.Minute, TouchInterval: time.Minute})
+	ring.find = func(ctx context.Context, p string) (apiKey, error) {
+		finds++
+		if p != stored.Prefix {
+			return apiKey{}, mgo.ErrNotFound
+		}
+		return stored, nil
+	}
+	owner := geoUser{ID: bson.NewObjectId(), Role: "moderator"}
+	ring.owner = func(ctx context.Context, u *geoUser) (geoUser, error) {
+		return owner, nil
+	}
+	ring.touch = func(ctx context.Context, id bson.ObjectId, at time.Time, ip string) error {
+		touched <- id
+		return nil
+	}
+
+	ctx := context.Background()
+	_, _, err = ring.lookup(ctx, raw, now)
+	assert.NoError(t, err)
+	_, _, err = ring.lookup(ctx, raw, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, finds, "second lookup is cached")
+	_, _, err = ring.lookup(ctx, keyPrefix+prefix+"_wrong", now)
+	assert.Equal(t, errKeyInvalid, err)
+	_, _, err = ring.lookup(ctx, "nope", now)
+	assert.Equal(t, errKeyInvalid, err)
+
+	stored.ExpiresAt = now
+	ring.forget(stored.ID)
+	_, _, err = ring.lookup(ctx, raw, now)
+	assert.Equal(t, errKeyExpired, err)
+	stored.ExpiresAt = time.Time{}
+	stored.RevokedAt = now
+	ring.forget(stored.ID)
+	_, _, err = ring.lookup(ctx, raw, now)
+	assert.Equal(t, errKeyInvalid, err)
+	stored.RevokedAt = time.Time{}
+	ring.forget(stored.ID)
+
+	gin.SetMode(gin.ReleaseMode)
+	conf := authConfig{}
+	eng := gin.New()
+	eng.Use(middlewareAPIKey(&conf, ring, &rateLimitConfig{}, nil))
+	ok200 := func(c *gin.Context) { c.Status(http.StatusOK) }
+	eng.GET("/api/v1/locs/all", ok200)
+	eng.POST("/api/v1/locs", ok200)
+	eng.GET("/api/v1/admin/keys", requireRole("admin"), ok200)
+	eng.GET("/api/v1/admin/queue", requireRole("moderator", "admin"), ok200)
+
+	do := func(method, url, key string) int {
+		req, _ := http.NewRequest(method, url, nil)
+		if key != "" {
+			req.Header.Set(apiKeyHeader, key)
+		}
+		response := httptest.NewRecorder()
+		eng.ServeHTTP(response, req)
+		return response.Code
+	}
+
+	assert.Equal(t, http.StatusOK, do("GET", "/api/v1/locs/all", ""), "anonymous")
+	assert.Equal(t, http.StatusUnauthorized, do("GET", "/api/v1/admin/keys", ""))
+	assert.Equal(t, http.StatusOK, do("GET", "/api/v1/locs/all", raw))
+	assert.Equal(t, stored.ID, <-touched)
+	assert.Equal(t, http.StatusForbidden, do("POST", "/api/v1/locs", raw))
+	assert.Equal(t, http.StatusForbidden, do("GET", "/api/v1/admin/keys", raw))
+	assert.Equal(t, http.StatusUnauthorized, do("GET", "/api/v1/locs/all", "gk_bad"))
+
+	// the key acts with the role of its owner, a suspended owner locks it
+	stored.UserID = owner.ID
+	ring.forget(stored.ID)
+	assert.Equal(t, http.StatusOK, do("GET", "/api/v1/admin/queue", raw))
+	assert.Equal(t, http.StatusForbidden, do("GET", "/api/v1/admin/keys", raw))
+	owner.Moderation = &moderation{Suspended: true}
+	ring.forget(owner.ID)
+	assert.Equal(t, http.StatusForbidden, do("GET", "/api/v1/locs/all", raw))
+
+	conf.Required = true
+	assert.Equal(t, http.StatusUnauthorized, do("GET", "/api/v1/locs/all", ""))
+}
+
+// the allowlist of a key sees the remote address, X-Forwarded-For counts
+// only when it comes from a trusted proxy
+func TestAPIKeyProxy(t *testing.T) {
+	raw, prefix, hash, err := newKeyMaterial()
+	assert.NoError(t, err)
+	stored := apiKey{ID: bson.NewObjectId(), Prefix: prefix, Hash: hash,
+		Scopes: []string{"read:locs"}, AllowIPs: []string{"10.0.0.7"}}
+
+	conf := defaultConfig()
+	db := &mongoDB{}
+	ring := newKeyring(db, &conf.Auth)
+	ring.find = func(ctx context.Context, p string) (apiKey, error) {
+		return stored, nil
+	}
+	code := func(proxies []string) string {
+		conf.Server.TrustedProxies = proxies
+		eng := router(conf, db, newIngester(db, &conf.Ingest), ring, newLimitStore(&conf.RateLimit))
+		// the key lacks write:locs, past the allowlist this is scope_missing
+		req, _ := http.NewRequest("POST", "/api/v1/locs", nil)
+		req.RemoteAddr = "203.0.113.9:4000"
+		req.Header.Set("X-Forwarded-For", "10.0.0.7")
+		req.Header.Set(apiKeyHeader, raw)
+		response := httptest.NewRecorder()
+		eng.ServeHTTP(response, req)
+		res := problem{}
+		json.Unmarshal(response.Body.Bytes(), &res)
+		return res.Code
+	}
+
+	assert.Equal(t, "key_ip_denied", code(nil), "spoofed X-Forwarded-For")
+	assert.Equal(t, "scope_missing", code([]string{"203.0.113.0/24"}), "X-Forwarded-For of a trusted proxy")
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== audit
+
+// auditMeta is who makes the writes of a request, it travels in the context
+// down to the db functions that record them
+type auditMeta struct {
+	Actor     string
+	Route     string
+	RequestID string
+}
+
+type auditKey struct{}
+
+func withAudit(ctx context.Context, meta auditMeta) context.Context {
+	return context.WithValue(ctx, auditKey{}, meta)
+}
+
+// auditFrom is the meta of ctx, writes outside of a request are the system's
+func auditFrom(ctx context.Context) auditMeta {
+	if meta, ok := ctx.Value(auditKey{}).(auditMeta); ok {
+		return meta
+	}
+	return auditMeta{Actor: "system"}
+}
+
+// middlewareAudit runs after middlewareAPIKey, so the actor is known
+func middlewareAudit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := withAudit(c.Request.Context(), auditMeta{
+			Actor:     actor(c),
+			Route:     c.Request.Method + " " + c.FullPath(),
+			RequestID: c.GetString("request_id"),
+		})
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// auditDoc is a document as the bson members the diff compares
+func auditDoc(doc interface{}) bson.M {
+	if doc == nil {
+		return nil
+	}
+	if v := reflect.ValueOf(doc); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	if m, ok := doc.(bson.M); ok {
+		return m
+	}
+	m := bson.M{}
+	data, err := bson.Marshal(doc)
+	if err == nil {
+		err = bson.Unmarshal(data, &m)
+	}
+	if err != nil {
+		return bson.M{"error": err.Error()}
+	}
+	return m
+}
+
+// diffDocs lists the members that differ, sorted by name
+func diffDocs(before, after bson.M) (changes []auditChange) {
+	fields := map[string]bool{}
+	for f := range before {
+		fields[f] = true
+	}
+	for f := range after {
+		fields[f] = true
+	}
+	delete(fields, "_id")
+	for f := range fields {
+		if !reflect.DeepEqual(before[f], after[f]) {
+			changes = append(changes, auditChange{Field: f, Before: before[f], After: after[f]})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+// entry is the audit log entry of c
+func (c change) entry() auditEntry {
+	return auditEntry{
+		ID:        c.ID,
+		At:        c.At,
+		Actor:     c.Actor,
+		Action:    c.Action,
+		Kind:      c.Kind,
+		Entity:    c.Entity,
+		Route:     c.Route,
+		RequestID: c.RequestID,
+		Changes:   diffDocs(c.Before, c.After),
+	}
+}
+
+// audit appends changes to the audit log, the moves of ingest included.
+// The write they record is done already, so a failure is logged rather
+// than returned.
+func (mongo *mongoDB) audit(ctx context.Context, changes ...change) {
+	entries := make([]auditEntry, len(changes))
+	for i := range changes {
+		entries[i] = changes[i].entry()
+	}
+	err := mongo.postAudit(ctx, entries)
+	if err != nil {
+		metrics.auditFailures.Inc()
+		slog.ErrorContext(ctx, "audit log write", "error", err.Error(),
+			"kind", entries[0].Kind, "entity", entries[0].Entity.Hex(), "entries", len(entries))
+	}
+}
+
+// ========== audit api
+
+func getAudit(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req reqAudit
+		err := c.ShouldBindQuery(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		entries, err := mongo.getAudit(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get audit complete", "body": entries})
+	}
+}
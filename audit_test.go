@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestAudit(t *testing.T) {
+	// case writes outside of a request
+	assert.Equal(t, "system", auditFrom(context.Background()).Actor)
+
+	id := bson.NewObjectId()
+	before := geoUser{ID: id, Name: "a", Tags: []string{"x"}, Version: 1, Role: "admin"}
+	after := geoUser{ID: id, Name: "b", Tags: []string{"x"}, Version: 2}
+
+	// case create, every member is a change
+	{
+		e := newChange(context.Background(), "user", "create", id, nil, after).entry()
+		assert.Equal(t, "system", e.Actor)
+		assert.Equal(t, id, e.Entity)
+		fields := []string{}
+		for _, c := range e.Changes {
+			fields = append(fields, c.Field)
+			assert.Nil(t, c.Before)
+		}
+		assert.Equal(t, []string{"name", "tags", "version"}, fields)
+	}
+	// case update, a replace keeps the protected fields
+	{
+		ctx := withAudit(context.Background(), auditMeta{Actor: "key:k", Route: "PUT /api/v1/users/:id", RequestID: "r"})
+		e := newChange(ctx, "user", "update", id, &before, &after).entry()
+		assert.Equal(t, "key:k", e.Actor)
+		assert.Equal(t, "PUT /api/v1/users/:id", e.Route)
+		assert.Equal(t, "r", e.RequestID)
+		assert.Equal(t, []auditChange{
+			{Field: "name", Before: "a", After: "b"},
+			{Field: "version", Before: int64(1), After: int64(2)},
+		}, e.Changes)
+	}
+	// case delete
+	{
+		var none *geoUser
+		e := newChange(context.Background(), "user", "delete", id, &before, none).entry()
+		assert.Len(t, e.Changes, 4)
+		for _, c := range e.Changes {
+			assert.Nil(t, c.After)
+		}
+	}
+}
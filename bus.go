@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== changes
+
+var changeKinds = []string{"user", "event", "location"}
+
+var changeSources = []string{"local", "oplog"}
+
+// changeActions name the write in the type of a change. A bulk upsert
+// does not tell a create from an update, a move is a device fix.
+var changeActions = map[string]string{
+	"create":    "created",
+	"update":    "updated",
+	"upsert":    "upserted",
+	"delete":    "deleted",
+	"move":      "moved",
+	"hide":      "hidden",
+	"unhide":    "unhidden",
+	"suspend":   "suspended",
+	"unsuspend": "unsuspended",
+	"role":      "role_changed",
+}
+
+// changeType is the type of a change, like location.created
+func changeType(kind, action string) string {
+	if past, ok := changeActions[action]; ok {
+		action = past
+	}
+	return kind + "." + action
+}
+
+// change is a domain event, a committed write to a user, event or
+// location. ID names it in the audit log and to webhooks, Seq is the order
+// the bus took it in. Before is nil for a create and After for a delete.
+type change struct {
+	ID        bson.ObjectId
+	Seq       uint64
+	Type      string
+	Kind      string
+	Action    string
+	Entity    bson.ObjectId
+	At        time.Time
+	Actor     string
+	Route     string
+	RequestID string
+	Before    bson.M
+	After     bson.M
+}
+
+// newChange is a write to the entity id of kind by the actor of ctx
+func newChange(ctx context.Context, kind, action string, id bson.ObjectId, before, after interface{}) change {
+	meta := auditFrom(ctx)
+	b, a := auditDoc(before), auditDoc(after)
+	if action == "update" && b != nil && a != nil {
+		// a replace keeps these, the doc may say anything about them
+		for _, f := range protectedFields {
+			if v, ok := b[f]; ok {
+				a[f] = v
+			} else {
+				delete(a, f)
+			}
+		}
+	}
+	return change{
+		ID:        bson.NewObjectId(),
+		Type:      changeType(kind, action),
+		Kind:      kind,
+		Action:    action,
+		Entity:    id,
+		At:        time.Now(),
+		Actor:     meta.Actor,
+		Route:     meta.Route,
+		RequestID: meta.RequestID,
+		Before:    b,
+		After:     a,
+	}
+}
+
+// doc is the document after the change, or before a delete
+func (c *change) doc() bson.M {
+	if c.After != nil {
+		return c.After
+	}
+	return c.Before
+}
+
+// decode reads the document of c into out
+func (c *change) decode(out interface{}) error {
+	data, err := bson.Marshal(c.doc())
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(data, out)
+}
+
+// ========== bus
+
+// handler is told about a change with the context of its subscription
+type handler func(ctx context.Context, c change)
+
+// subscription hands the changes of the bus to a handler. An async one
+// has shards goroutines and the changes of an entity always go to the
+// same one, so they are handled in order. A full shard drops the change
+// for this subscriber alone. A sync one is called by publish and must
+// neither block nor publish.
+type subscription struct {
+	name   string
+	ctx    context.Context
+	h      handler
+	shards []chan change
+	// dropped counts the changes lost since it fell behind, under bus.mu
+	dropped int
+}
+
+// bus is the publish/subscribe of changes inside the service. Local
+// writes publish to it, or with the oplog source the oplog tailer does
+// and they are left out so nothing is seen twice.
+type bus struct {
+	mu     sync.Mutex
+	seq    uint64
+	subs   map[*subscription]bool
+	shards int
+	oplog  bool
+}
+
+func newBus(conf *changesConfig) *bus {
+	return &bus{
+		subs:   map[*subscription]bool{},
+		shards: conf.Shards,
+		oplog:  conf.Source == "oplog",
+	}
+}
+
+// subscribe hands every change to h until ctx is done or unsubscribe is
+// called, with buffer 0 it is sync. b may be nil, there is nothing to
+// hear then.
+func (b *bus) subscribe(ctx context.Context, name string, buffer int, h handler) (unsubscribe func()) {
+	if b == nil {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &subscription{name: name, ctx: ctx, h: h}
+	for i := 0; buffer > 0 && i < b.shards; i++ {
+		ch := make(chan change, buffer)
+		s.shards = append(s.shards, ch)
+		go func() {
+			for {
+				select {
+				case c := <-ch:
+					h(ctx, c)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	b.mu.Lock()
+	b.subs[s] = true
+	b.mu.Unlock()
+	return func() {
+		b.mu.Lock()
+		delete(b.subs, s)
+		b.mu.Unlock()
+		cancel()
+	}
+}
+
+// publish hands changes to every subscriber in the order given, b may be
+// nil
+func (b *bus) publish(changes ...change) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, c := range changes {
+		b.seq++
+		c.Seq = b.seq
+		for s := range b.subs {
+			s.offer(c)
+		}
+	}
+}
+
+func (s *subscription) offer(c change) {
+	if s.shards == nil {
+		s.h(s.ctx, c)
+		return
+	}
+	h := fnv.New32a()
+	h.Write([]byte(c.Entity))
+	select {
+	case s.shards[h.Sum32()%uint32(len(s.shards))] <- c:
+		metrics.changes.WithLabelValues(s.name, "queued").Inc()
+		if s.dropped > 0 {
+			slog.Warn("bus subscriber caught up", "subscriber", s.name, "dropped", s.dropped)
+			s.dropped = 0
+		}
+	default:
+		// async subscribers hear of a change at most once, this is lost
+		metrics.changes.WithLabelValues(s.name, "dropped").Inc()
+		if s.dropped == 0 {
+			slog.Warn("bus subscriber behind, dropping changes", "subscriber", s.name,
+				"change", c.Type, "entity", c.Entity.Hex())
+		}
+		s.dropped++
+	}
+}
+
+// publish tells about changes after they are committed. The audit log
+// records them in line, it needs who made them and only local writes
+// know that. The bus has them unless the oplog feeds it.
+func (mongo *mongoDB) publish(ctx context.Context, changes ...change) {
+	if len(changes) == 0 {
+		return
+	}
+	mongo.audit(ctx, changes...)
+	if mongo.bus != nil && !mongo.bus.oplog {
+		mongo.bus.publish(changes...)
+	}
+}
+
+// ========== oplog
+
+// oplogEntry is a write in the oplog of a replica set
+type oplogEntry struct {
+	TS bson.MongoTimestamp `bson:"ts"`
+	Op string              `bson:"op"`
+	NS string              `bson:"ns"`
+	O  bson.M              `bson:"o"`
+	O2 bson.M              `bson:"o2"`
+}
+
+// oplogChange is the change of an oplog entry to a collection of db. Its
+// ID comes from the entry, so every instance tailing the oplog names it
+// the same. An update only has the id, the tailer reads the document.
+func oplogChange(db string, e oplogEntry) (c change, ok bool) {
+	name := strings.TrimPrefix(e.NS, db+".")
+	kind := collKinds[name]
+	if kind == "" || name == e.NS {
+		return c, false
+	}
+	var doc bson.M
+	switch e.Op {
+	case "i":
+		c.Action, doc, c.After = "create", e.O, e.O
+	case "u":
+		c.Action, doc = "update", e.O2
+	case "d":
+		c.Action, doc, c.Before = "delete", e.O, e.O
+	default:
+		return c, false
+	}
+	c.Entity, ok = doc["_id"].(bson.ObjectId)
+	if !ok {
+		return c, false
+	}
+
+	id := make([]byte, 12)
+	binary.BigEndian.PutUint64(id, uint64(e.TS))
+	h := fnv.New32a()
+	h.Write([]byte(e.NS + string(c.Entity)))
+	binary.BigEndian.PutUint32(id[8:], h.Sum32())
+	c.ID = bson.ObjectId(id)
+	c.Kind = kind
+	c.Type = changeType(kind, c.Action)
+	c.At = time.Unix(int64(uint64(e.TS)>>32), 0)
+	return c, true
+}
+
+// tailOplog publishes the writes of every instance, and of other tools, to
+// the users, events and locations from the oplog, starting after its
+// newest entry. It needs a replica set and reads local.oplog.rs.
+// A failed cursor is opened again after the reconnect backoff.
+func (mongo *mongoDB) tailOplog(ctx context.Context, conf *mongoConfig) {
+	ns := []string{}
+	for name := range collKinds {
+		ns = append(ns, mongo.Database+"."+name)
+	}
+	var last bson.MongoTimestamp
+	wait := conf.ReconnectMin
+	for ctx.Err() == nil {
+		from := last
+		err := mongo.tail(ctx, ns, &last)
+		if ctx.Err() != nil {
+			return
+		}
+		if last != from {
+			wait = conf.ReconnectMin
+		}
+		slog.Error("oplog tail", "error", err.Error(), "retry_in", wait.String())
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+		wait = min(wait*2, conf.ReconnectMax)
+	}
+}
+
+// tail follows the oplog from last until ctx is done or the cursor fails
+func (mongo *mongoDB) tail(ctx context.Context, ns []string, last *bson.MongoTimestamp) (err error) {
+	session := mongo.Session.Copy()
+	defer session.Close()
+	oplog := session.DB("local").C("oplog.rs")
+	if *last == 0 {
+		var top oplogEntry
+		err = oplog.Find(nil).Sort("-$natural").One(&top)
+		if err != nil {
+			return err
+		}
+		*last = top.TS
+	}
+
+	iter := oplog.Find(bson.M{"ts": bson.M{"$gt": *last}, "ns": bson.M{"$in": ns}}).
+		LogReplay().Tail(time.Second)
+	defer iter.Close()
+	for {
+		var e oplogEntry
+		for iter.Next(&e) {
+			*last = e.TS
+			c, ok := oplogChange(mongo.Database, e)
+			if ok && c.Action == "update" {
+				c.After = bson.M{}
+				err = session.DB(mongo.Database).C(strings.TrimPrefix(e.NS, mongo.Database+".")).
+					FindId(c.Entity).One(&c.After)
+				// removed since, its delete follows
+				ok = err == nil
+				if err != nil && err != mgo.ErrNotFound {
+					return err
+				}
+			}
+			if ok {
+				mongo.bus.publish(c)
+			}
+			e = oplogEntry{}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !iter.Timeout() {
+			if err = iter.Err(); err != nil {
+				return err
+			}
+			return errors.New("oplog cursor closed")
+		}
+	}
+}
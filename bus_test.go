@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestBusOrder(t *testing.T) {
+	b := newBus(&changesConfig{Source: "local", Shards: 4})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ids := []bson.ObjectId{bson.NewObjectId(), bson.NewObjectId(), bson.NewObjectId()}
+	var mu sync.Mutex
+	got := map[bson.ObjectId][]uint64{}
+	var wg sync.WaitGroup
+	wg.Add(len(ids) * 100)
+	b.subscribe(ctx, "test", 1000, func(_ context.Context, c change) {
+		mu.Lock()
+		got[c.Entity] = append(got[c.Entity], c.Seq)
+		mu.Unlock()
+		wg.Done()
+	})
+	var heard []change
+	b.subscribe(ctx, "sync", 0, func(_ context.Context, c change) {
+		heard = append(heard, c)
+	})
+
+	for i := 0; i < 100; i++ {
+		for _, id := range ids {
+			b.publish(change{Entity: id, Kind: "location"})
+		}
+	}
+	wg.Wait()
+	// every entity is seen in the order it was published
+	for _, id := range ids {
+		assert.Len(t, got[id], 100)
+		for i := 1; i < len(got[id]); i++ {
+			assert.Less(t, got[id][i-1], got[id][i])
+		}
+	}
+	// a sync subscriber is called by publish
+	assert.Len(t, heard, 300)
+	assert.Equal(t, uint64(300), heard[299].Seq)
+}
+
+func TestBusDrop(t *testing.T) {
+	b := newBus(&changesConfig{Source: "local", Shards: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	block := make(chan struct{})
+	seen := make(chan change, 10)
+	unsubscribe := b.subscribe(ctx, "slow", 1, func(_ context.Context, c change) {
+		<-block
+		seen <- c
+	})
+	id := bson.NewObjectId()
+	// one is handled, one waits, the rest are dropped
+	for i := 0; i < 5; i++ {
+		b.publish(change{Entity: id})
+		time.Sleep(10 * time.Millisecond)
+	}
+	b.mu.Lock()
+	for s := range b.subs {
+		assert.Equal(t, 3, s.dropped)
+	}
+	b.mu.Unlock()
+	close(block)
+	assert.Equal(t, uint64(1), (<-seen).Seq)
+	assert.Equal(t, uint64(2), (<-seen).Seq)
+
+	unsubscribe()
+	b.publish(change{Entity: id})
+	select {
+	case c := <-seen:
+		t.Fatalf("got %d after unsubscribe", c.Seq)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// a nil bus has nothing to hear
+	var none *bus
+	none.subscribe(ctx, "none", 0, func(context.Context, change) { t.Fatal("called") })()
+	none.publish(change{Entity: id})
+}
+
+func TestBusOplog(t *testing.T) {
+	id := bson.NewObjectId()
+	ts := bson.MongoTimestamp(1700000000<<32 | 7)
+
+	c, ok := oplogChange("geoloc", oplogEntry{TS: ts, Op: "i", NS: "geoloc.dviEvents", O: bson.M{"_id": id, "name": "x"}})
+	assert.True(t, ok)
+	assert.Equal(t, "event.created", c.Type)
+	assert.Equal(t, id, c.Entity)
+	assert.Equal(t, "x", c.After["name"])
+	assert.Nil(t, c.Before)
+	assert.Equal(t, int64(1700000000), c.At.Unix())
+
+	// every instance names it the same
+	again, _ := oplogChange("geoloc", oplogEntry{TS: ts, Op: "i", NS: "geoloc.dviEvents", O: bson.M{"_id": id}})
+	assert.Equal(t, c.ID, again.ID)
+
+	c, ok = oplogChange("geoloc", oplogEntry{TS: ts, Op: "u", NS: "geoloc.dviLocations",
+		O: bson.M{"$set": bson.M{"version": 2}}, O2: bson.M{"_id": id}})
+	assert.True(t, ok)
+	assert.Equal(t, "location.updated", c.Type)
+	assert.Equal(t, id, c.Entity)
+	assert.Nil(t, c.After)
+
+	c, ok = oplogChange("geoloc", oplogEntry{TS: ts, Op: "d", NS: "geoloc.dviUsers", O: bson.M{"_id": id}})
+	assert.True(t, ok)
+	assert.Equal(t, "user.deleted", c.Type)
+	assert.Equal(t, id, c.Before["_id"])
+
+	for _, e := range []oplogEntry{
+		{TS: ts, Op: "i", NS: "geoloc.dviAudit", O: bson.M{"_id": id}},
+		{TS: ts, Op: "i", NS: "other.dviUsers", O: bson.M{"_id": id}},
+		{TS: ts, Op: "n", NS: "geoloc.dviUsers", O: bson.M{}},
+		{TS: ts, Op: "i", NS: "geoloc.dviUsers", O: bson.M{"_id": "not an id"}},
+	} {
+		_, ok = oplogChange("geoloc", e)
+		assert.False(t, ok, "%+v", e)
+	}
+}
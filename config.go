@@ -0,0 +1,773 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	mgo "gopkg.in/mgo.v2"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ========== config
+
+// config is loaded from defaults, then a yaml/toml file, then env, then flags
+type (
+	config struct {
+		Listen     string          `yaml:"listen" toml:"listen"`
+		StaticDir  string          `yaml:"static_dir" toml:"static_dir"`
+		LogLevel   string          `yaml:"log_level" toml:"log_level"`
+		StrictJSON bool            `yaml:"strict_json" toml:"strict_json"`
+		Server     serverConfig    `yaml:"server" toml:"server"`
+		Tracing    tracingConfig   `yaml:"tracing" toml:"tracing"`
+		Ingest     ingestConfig    `yaml:"ingest" toml:"ingest"`
+		GRPC       grpcConfig      `yaml:"grpc" toml:"grpc"`
+		RateLimit  rateLimitConfig `yaml:"rate_limit" toml:"rate_limit"`
+		CORS       corsConfig      `yaml:"cors" toml:"cors"`
+		Auth       authConfig      `yaml:"auth" toml:"auth"`
+		Notify     notifyConfig    `yaml:"notify" toml:"notify"`
+		Webhooks   webhookConfig   `yaml:"webhooks" toml:"webhooks"`
+		Mongo      mongoConfig     `yaml:"mongo" toml:"mongo"`
+	}
+
+	tracingConfig struct {
+		Exporter    string  `yaml:"exporter" toml:"exporter"`
+		Endpoint    string  `yaml:"endpoint" toml:"endpoint"`
+		Insecure    bool    `yaml:"insecure" toml:"insecure"`
+		ServiceName string  `yaml:"service_name" toml:"service_name"`
+		SampleRatio float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+	}
+
+	serverConfig struct {
+		ReadTimeout     time.Duration `yaml:"read_timeout" toml:"read_timeout"`
+		WriteTimeout    time.Duration `yaml:"write_timeout" toml:"write_timeout"`
+		IdleTimeout     time.Duration `yaml:"idle_timeout" toml:"idle_timeout"`
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+		BulkMaxItems    int           `yaml:"bulk_max_items" toml:"bulk_max_items"`
+		// TrustedProxies are the ips or cidrs whose X-Forwarded-For gives the
+		// client ip, by default none and the client is the remote address
+		TrustedProxies []string `yaml:"trusted_proxies" toml:"trusted_proxies"`
+	}
+
+	ingestConfig struct {
+		QueueSize     int           `yaml:"queue_size" toml:"queue_size"`
+		QueueWait     time.Duration `yaml:"queue_wait" toml:"queue_wait"`
+		BatchSize     int           `yaml:"batch_size" toml:"batch_size"`
+		FlushInterval time.Duration `yaml:"flush_interval" toml:"flush_interval"`
+		Writers       int           `yaml:"writers" toml:"writers"`
+		MaxBodyBytes  int64         `yaml:"max_body_bytes" toml:"max_body_bytes"`
+		// MaxSkew is how far past the server clock a fix ts may be
+		MaxSkew time.Duration `yaml:"max_skew" toml:"max_skew"`
+	}
+
+	grpcConfig struct {
+		Listen        string        `yaml:"listen" toml:"listen"`
+		WatchInterval time.Duration `yaml:"watch_interval" toml:"watch_interval"`
+	}
+
+	rateLimitConfig struct {
+		Enabled         bool        `yaml:"enabled" toml:"enabled"`
+		Backend         string      `yaml:"backend" toml:"backend"`
+		RedisURL        string      `yaml:"redis_url" toml:"redis_url"`
+		Read            limitBudget `yaml:"read" toml:"read"`
+		Write           limitBudget `yaml:"write" toml:"write"`
+		Expensive       limitBudget `yaml:"expensive" toml:"expensive"`
+		ExpensiveRoutes []string    `yaml:"expensive_routes" toml:"expensive_routes"`
+	}
+
+	// limitBudget is a token bucket, Rate tokens a second up to Burst
+	limitBudget struct {
+		Rate  float64 `yaml:"rate" toml:"rate"`
+		Burst int     `yaml:"burst" toml:"burst"`
+	}
+
+	// corsPolicy is what pages on other origins may do. An origin is exact,
+	// "*" or has a wildcard host like "https://*.example.com".
+	corsPolicy struct {
+		Origins        []string      `yaml:"origins" toml:"origins"`
+		Methods        []string      `yaml:"methods" toml:"methods"`
+		Headers        []string      `yaml:"headers" toml:"headers"`
+		ExposedHeaders []string      `yaml:"exposed_headers" toml:"exposed_headers"`
+		Credentials    bool          `yaml:"credentials" toml:"credentials"`
+		MaxAge         time.Duration `yaml:"max_age" toml:"max_age"`
+	}
+
+	// corsConfig is the default policy and overrides keyed by route group
+	// prefix, a group inherits the fields it leaves empty except credentials
+	corsConfig struct {
+		corsPolicy `yaml:",inline"`
+		Groups     map[string]corsPolicy `yaml:"groups" toml:"groups"`
+	}
+
+	// authConfig is about api keys, without Required a request without a key
+	// is anonymous and only the admin routes need one
+	authConfig struct {
+		Required bool `yaml:"required" toml:"required"`
+		// CacheTTL is how long a key is trusted before it is read again, so
+		// a revoke takes up to this long on the other instances
+		CacheTTL time.Duration `yaml:"cache_ttl" toml:"cache_ttl"`
+		// TouchInterval throttles the last used writes of a key
+		TouchInterval time.Duration `yaml:"touch_interval" toml:"touch_interval"`
+	}
+
+	// notifyConfig is the notification outbox and its delivery channels
+	notifyConfig struct {
+		Enabled bool `yaml:"enabled" toml:"enabled"`
+		// Channels are the sinks that deliver, and the default of users
+		// that did not choose
+		Channels  []string `yaml:"channels" toml:"channels"`
+		File      string   `yaml:"file" toml:"file"`
+		QueueSize int      `yaml:"queue_size" toml:"queue_size"`
+		// MaxRadius caps the radius of the users an event is matched with
+		MaxRadius float64 `yaml:"max_radius" toml:"max_radius"`
+		// FriendCooldown is how long a friend nearby is not told again
+		FriendCooldown time.Duration `yaml:"friend_cooldown" toml:"friend_cooldown"`
+		PollInterval   time.Duration `yaml:"poll_interval" toml:"poll_interval"`
+		BatchSize      int           `yaml:"batch_size" toml:"batch_size"`
+		// Lease is how long a delivery may take before another try
+		Lease       time.Duration `yaml:"lease" toml:"lease"`
+		MaxAttempts int           `yaml:"max_attempts" toml:"max_attempts"`
+		BackoffMin  time.Duration `yaml:"backoff_min" toml:"backoff_min"`
+		BackoffMax  time.Duration `yaml:"backoff_max" toml:"backoff_max"`
+		// WebhookTimeout bounds one webhook delivery
+		WebhookTimeout time.Duration `yaml:"webhook_timeout" toml:"webhook_timeout"`
+		// WebhookSecret signs the bodies posted to the webhooks of users
+		WebhookSecret string     `yaml:"webhook_secret" toml:"webhook_secret"`
+		SMTP          smtpConfig `yaml:"smtp" toml:"smtp"`
+	}
+
+	smtpConfig struct {
+		Addr     string `yaml:"addr" toml:"addr"`
+		From     string `yaml:"from" toml:"from"`
+		Username string `yaml:"username" toml:"username"`
+		Password string `yaml:"password" toml:"password"`
+	}
+
+	// webhookConfig is the delivery of audited writes to the webhooks
+	// admins subscribe
+	webhookConfig struct {
+		Enabled      bool          `yaml:"enabled" toml:"enabled"`
+		QueueSize    int           `yaml:"queue_size" toml:"queue_size"`
+		PollInterval time.Duration `yaml:"poll_interval" toml:"poll_interval"`
+		BatchSize    int           `yaml:"batch_size" toml:"batch_size"`
+		// Lease is how long a delivery may take before another try
+		Lease time.Duration `yaml:"lease" toml:"lease"`
+		// MaxAttempts are made before a delivery is dead
+		MaxAttempts int           `yaml:"max_attempts" toml:"max_attempts"`
+		BackoffMin  time.Duration `yaml:"backoff_min" toml:"backoff_min"`
+		BackoffMax  time.Duration `yaml:"backoff_max" toml:"backoff_max"`
+		Timeout     time.Duration `yaml:"timeout" toml:"timeout"`
+	}
+
+	mongoConfig struct {
+		URI              string        `yaml:"uri" toml:"uri"`
+		Host             string        `yaml:"host" toml:"host"`
+		Port             string        `yaml:"port" toml:"port"`
+		Database         string        `yaml:"database" toml:"database"`
+		Username         string        `yaml:"username" toml:"username"`
+		Password         string        `yaml:"password" toml:"password"`
+		Source           string        `yaml:"source" toml:"source"`
+		Timeout          time.Duration `yaml:"timeout" toml:"timeout"`
+		PoolLimit        int           `yaml:"pool_limit" toml:"pool_limit"`
+		PingInterval     time.Duration `yaml:"ping_interval" toml:"ping_interval"`
+		ReconnectMin     time.Duration `yaml:"reconnect_min" toml:"reconnect_min"`
+		ReconnectMax     time.Duration `yaml:"reconnect_max" toml:"reconnect_max"`
+		EventTTLAfterEnd time.Duration `yaml:"event_ttl_after_end" toml:"event_ttl_after_end"`
+		StdEventTTL      time.Duration `yaml:"std_event_ttl" toml:"std_event_ttl"`
+		EventExpire      time.Duration `yaml:"event_expire" toml:"event_expire"`
+		// AuditRetention is how long the audit log keeps an entry
+		AuditRetention time.Duration `yaml:"audit_retention" toml:"audit_retention"`
+		// NotifyRetention is how long the notification outbox keeps an entry
+		NotifyRetention time.Duration `yaml:"notify_retention" toml:"notify_retention"`
+		// HookRetention is how long a delivered webhook delivery is kept
+		HookRetention time.Duration `yaml:"hook_retention" toml:"hook_retention"`
+		Privacy       privacyConfig `yaml:"privacy" toml:"privacy"`
+		Changes       changesConfig `yaml:"changes" toml:"changes"`
+	}
+
+	// changesConfig is the change bus, Source local publishes the writes of
+	// this instance and oplog tails the oplog of the replica set for the
+	// writes of all of them. Shards is the goroutines of a subscriber.
+	changesConfig struct {
+		Source string `yaml:"source" toml:"source"`
+		Shards int    `yaml:"shards" toml:"shards"`
+	}
+
+	// privacyConfig is the privacy of users that did not choose one and
+	// the cells locations are fuzzed to
+	privacyConfig struct {
+		Visibility string `yaml:"visibility" toml:"visibility"`
+		Precision  string `yaml:"precision" toml:"precision"`
+		// GridSize is the side of a grid cell in meters
+		GridSize      float64 `yaml:"grid_size" toml:"grid_size"`
+		GeohashLength int     `yaml:"geohash_length" toml:"geohash_length"`
+	}
+)
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+func defaultConfig() *config {
+	return &config{
+		Listen:    ":8081",
+		StaticDir: STATIC_FOLDER,
+		LogLevel:  "info",
+		Server: serverConfig{
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    30 * time.Second,
+			IdleTimeout:     2 * time.Minute,
+			ShutdownTimeout: 20 * time.Second,
+			BulkMaxItems:    1000,
+		},
+		Tracing: tracingConfig{
+			Exporter:    "none",
+			ServiceName: "geoloc",
+			SampleRatio: 1,
+		},
+		Ingest: ingestConfig{
+			QueueSize:     1024,
+			QueueWait:     100 * time.Millisecond,
+			BatchSize:     1000,
+			FlushInterval: time.Second,
+			Writers:       4,
+			MaxBodyBytes:  8 << 20,
+			MaxSkew:       time.Minute,
+		},
+		GRPC: grpcConfig{
+			Listen:        ":9090",
+			WatchInterval: 2 * time.Second,
+		},
+		RateLimit: rateLimitConfig{
+			Enabled:         true,
+			Backend:         "memory",
+			Read:            limitBudget{Rate: 20, Burst: 60},
+			Write:           limitBudget{Rate: 5, Burst: 20},
+			Expensive:       limitBudget{Rate: 1, Burst: 5},
+			ExpensiveRoutes: []string{"/api/v1/locs/filter", "/api/v1/graphql"},
+		},
+		CORS: corsConfig{corsPolicy: corsPolicy{
+			Origins: []string{"*"},
+			Methods: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+			Headers: []string{"Accept", "Authorization", "Content-Type",
+				"If-Match", "If-None-Match", "X-API-Key", "X-Request-ID"},
+			ExposedHeaders: []string{"ETag", "Location", "X-Request-ID", "Retry-After",
+				"RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset",
+				"Deprecation", "Link"},
+			MaxAge: 10 * time.Minute,
+		}},
+		Auth: authConfig{
+			CacheTTL:      30 * time.Second,
+			TouchInterval: time.Minute,
+		},
+		Notify: notifyConfig{
+			Channels:       []string{"log"},
+			QueueSize:      1024,
+			MaxRadius:      50000,
+			FriendCooldown: time.Hour,
+			PollInterval:   time.Second,
+			BatchSize:      100,
+			Lease:          time.Minute,
+			MaxAttempts:    8,
+			BackoffMin:     5 * time.Second,
+			BackoffMax:     time.Hour,
+			WebhookTimeout: 10 * time.Second,
+		},
+		Webhooks: webhookConfig{
+			QueueSize:    1024,
+			PollInterval: time.Second,
+			BatchSize:    100,
+			Lease:        time.Minute,
+			MaxAttempts:  10,
+			BackoffMin:   10 * time.Second,
+			BackoffMax:   6 * time.Hour,
+			Timeout:      10 * time.Second,
+		},
+		Mongo: mongoConfig{
+			Host:             "localhost",
+			Port:             "27017",
+			Database:         "geoloc",
+			Timeout:          10 * time.Second,
+			PingInterval:     5 * time.Second,
+			ReconnectMin:     500 * time.Millisecond,
+			ReconnectMax:     30 * time.Second,
+			EventTTLAfterEnd: 1 * time.Second,
+			StdEventTTL:      20 * time.Minute,
+			EventExpire:      30 * time.Second,
+			AuditRetention:   90 * 24 * time.Hour,
+			NotifyRetention:  7 * 24 * time.Hour,
+			HookRetention:    7 * 24 * time.Hour,
+			Changes: changesConfig{
+				Source: "local",
+				Shards: 4,
+			},
+			Privacy: privacyConfig{
+				Visibility:    "public",
+				Precision:     "exact",
+				GridSize:      1000,
+				GeohashLength: 6,
+			},
+		},
+	}
+}
+
+// loadConfig builds a config from defaults, the optional file and env
+func loadConfig(path string) (conf *config, err error) {
+	conf = defaultConfig()
+	if path != "" {
+		err = conf.loadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = conf.loadEnv()
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (conf *config) loadFile(path string) (err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config: %v", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(data, conf)
+	case ".toml":
+		// toml leaves unknown keys alone, fail on them like the yaml does
+		var md toml.MetaData
+		md, err = toml.Decode(string(data), conf)
+		if err == nil && len(md.Undecoded()) > 0 {
+			err = fmt.Errorf("unknown keys %v", md.Undecoded())
+		}
+	default:
+		return fmt.Errorf("config %s: unknown format, use .yaml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("parse config %s: %v", path, err)
+	}
+	return nil
+}
+
+func (conf *config) loadEnv() (err error) {
+	if v := os.Getenv("PORT"); v != "" {
+		conf.Listen = ":" + v
+	}
+	if v := os.Getenv("LISTEN"); v != "" {
+		conf.Listen = v
+	}
+	if v := os.Getenv("STATIC_FOLDER"); v != "" {
+		conf.StaticDir = v
+	}
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		conf.LogLevel = v
+	}
+	if v := os.Getenv("STRICT_JSON"); v != "" {
+		conf.StrictJSON, err = strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("env STRICT_JSON: %v", err)
+		}
+	}
+	if v := os.Getenv("AUTH_REQUIRED"); v != "" {
+		conf.Auth.Required, err = strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("env AUTH_REQUIRED: %v", err)
+		}
+	}
+	if v := os.Getenv("NOTIFY_ENABLED"); v != "" {
+		conf.Notify.Enabled, err = strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("env NOTIFY_ENABLED: %v", err)
+		}
+	}
+	if v := os.Getenv("WEBHOOKS_ENABLED"); v != "" {
+		conf.Webhooks.Enabled, err = strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("env WEBHOOKS_ENABLED: %v", err)
+		}
+	}
+	if v := os.Getenv("NOTIFY_CHANNELS"); v != "" {
+		conf.Notify.Channels = splitList(v)
+	}
+	if v := os.Getenv("TRACING_EXPORTER"); v != "" {
+		conf.Tracing.Exporter = v
+	}
+	if v := os.Getenv("TRACING_ENDPOINT"); v != "" {
+		conf.Tracing.Endpoint = v
+	}
+	if v := os.Getenv("CORS_ORIGINS"); v != "" {
+		conf.CORS.Origins = splitList(v)
+	}
+	if v := os.Getenv("TRUSTED_PROXIES"); v != "" {
+		conf.Server.TrustedProxies = splitList(v)
+	}
+
+	envStr := map[string]*string{
+		"GRPC_LISTEN":           &conf.GRPC.Listen,
+		"RATE_LIMIT_BACKEND":    &conf.RateLimit.Backend,
+		"RATE_LIMIT_REDIS_URL":  &conf.RateLimit.RedisURL,
+		"MONGO_URI":             &conf.Mongo.URI,
+		"MONGO_HOST":            &conf.Mongo.Host,
+		"MONGO_PORT":            &conf.Mongo.Port,
+		"MONGO_NAME":            &conf.Mongo.Database,
+		"MONGO_USER":            &conf.Mongo.Username,
+		"MONGO_PASSWORD":        &conf.Mongo.Password,
+		"MONGO_SOURCE":          &conf.Mongo.Source,
+		"PRIVACY_VISIBILITY":    &conf.Mongo.Privacy.Visibility,
+		"PRIVACY_PRECISION":     &conf.Mongo.Privacy.Precision,
+		"CHANGES_SOURCE":        &conf.Mongo.Changes.Source,
+		"NOTIFY_FILE":           &conf.Notify.File,
+		"NOTIFY_WEBHOOK_SECRET": &conf.Notify.WebhookSecret,
+		"SMTP_ADDR":             &conf.Notify.SMTP.Addr,
+		"SMTP_FROM":             &conf.Notify.SMTP.From,
+		"SMTP_USERNAME":         &conf.Notify.SMTP.Username,
+		"SMTP_PASSWORD":         &conf.Notify.SMTP.Password,
+	}
+	for name, field := range envStr {
+		if v := os.Getenv(name); v != "" {
+			*field = v
+		}
+	}
+
+	envDur := map[string]*time.Duration{
+		"READ_TIMEOUT":        &conf.Server.ReadTimeout,
+		"WRITE_TIMEOUT":       &conf.Server.WriteTimeout,
+		"IDLE_TIMEOUT":        &conf.Server.IdleTimeout,
+		"SHUTDOWN_TIMEOUT":    &conf.Server.ShutdownTimeout,
+		"MONGO_TIMEOUT":       &conf.Mongo.Timeout,
+		"MONGO_PING_INTERVAL": &conf.Mongo.PingInterval,
+		"MONGO_RECONNECT_MIN": &conf.Mongo.ReconnectMin,
+		"MONGO_RECONNECT_MAX": &conf.Mongo.ReconnectMax,
+		"EVENT_TTL_AFTER_END": &conf.Mongo.EventTTLAfterEnd,
+		"STD_EVENT_TTL":       &conf.Mongo.StdEventTTL,
+		"EVENT_EXPIRE":        &conf.Mongo.EventExpire,
+		"AUDIT_RETENTION":     &conf.Mongo.AuditRetention,
+		"NOTIFY_RETENTION":    &conf.Mongo.NotifyRetention,
+		"HOOK_RETENTION":      &conf.Mongo.HookRetention,
+	}
+	for name, field := range envDur {
+		if v := os.Getenv(name); v != "" {
+			*field, err = time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("env %s: %v", name, err)
+			}
+		}
+	}
+
+	envInt := map[string]*int{
+		"BULK_MAX_ITEMS":   &conf.Server.BulkMaxItems,
+		"MONGO_POOL_LIMIT": &conf.Mongo.PoolLimit,
+	}
+	for name, field := range envInt {
+		if v := os.Getenv(name); v != "" {
+			*field, err = strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("env %s: %v", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validate reports every invalid option at once
+func (conf *config) validate() error {
+	errs := []string{}
+
+	if _, _, err := net.SplitHostPort(conf.Listen); err != nil {
+		errs = append(errs, fmt.Sprintf("listen %q: %v", conf.Listen, err))
+	}
+	if conf.StaticDir == "" {
+		errs = append(errs, "static_dir is empty")
+	}
+	if !contains(logLevels, conf.LogLevel) {
+		errs = append(errs, fmt.Sprintf("log_level %q: want one of %s",
+			conf.LogLevel, strings.Join(logLevels, ", ")))
+	}
+	if conf.Server.ReadTimeout < 0 || conf.Server.WriteTimeout < 0 ||
+		conf.Server.IdleTimeout < 0 {
+		errs = append(errs, "server timeouts must not be negative")
+	}
+	if conf.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, "server.shutdown_timeout must be positive")
+	}
+	if conf.Server.BulkMaxItems <= 0 {
+		errs = append(errs, "server.bulk_max_items must be positive")
+	}
+	for _, proxy := range conf.Server.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			errs = append(errs, fmt.Sprintf("server.trusted_proxies %q: want an ip or cidr", proxy))
+		}
+	}
+	if conf.Ingest.QueueSize <= 0 || conf.Ingest.BatchSize <= 0 ||
+		conf.Ingest.Writers <= 0 || conf.Ingest.MaxBodyBytes <= 0 {
+		errs = append(errs, "ingest sizes and writers must be positive")
+	}
+	if conf.Ingest.QueueWait < 0 || conf.Ingest.MaxSkew < 0 || conf.Ingest.FlushInterval <= 0 {
+		errs = append(errs, "ingest.flush_interval must be positive, queue_wait and max_skew not negative")
+	}
+	if conf.GRPC.Listen != "" {
+		if _, _, err := net.SplitHostPort(conf.GRPC.Listen); err != nil {
+			errs = append(errs, fmt.Sprintf("grpc.listen %q: %v", conf.GRPC.Listen, err))
+		}
+	}
+	if conf.GRPC.WatchInterval <= 0 {
+		errs = append(errs, "grpc.watch_interval must be positive")
+	}
+	if !contains(limitBackends, conf.RateLimit.Backend) {
+		errs = append(errs, fmt.Sprintf("rate_limit.backend %q: want one of %s",
+			conf.RateLimit.Backend, strings.Join(limitBackends, ", ")))
+	}
+	if conf.RateLimit.Backend == "redis" && conf.RateLimit.RedisURL == "" {
+		errs = append(errs, "rate_limit.redis_url is empty")
+	}
+	for name, budget := range map[string]limitBudget{
+		"read":      conf.RateLimit.Read,
+		"write":     conf.RateLimit.Write,
+		"expensive": conf.RateLimit.Expensive,
+	} {
+		if budget.Rate <= 0 || budget.Burst < 1 {
+			errs = append(errs, fmt.Sprintf("rate_limit.%s: want rate > 0 and burst >= 1", name))
+		}
+	}
+	if !contains(tracingExporters, conf.Tracing.Exporter) {
+		errs = append(errs, fmt.Sprintf("tracing.exporter %q: want one of %s",
+			conf.Tracing.Exporter, strings.Join(tracingExporters, ", ")))
+	}
+	if conf.Tracing.Exporter == "otlp" && conf.Tracing.Endpoint == "" {
+		errs = append(errs, "tracing.endpoint is empty")
+	}
+	if conf.Tracing.SampleRatio < 0 || conf.Tracing.SampleRatio > 1 {
+		errs = append(errs, "tracing.sample_ratio: want 0..1")
+	}
+	errs = append(errs, conf.CORS.corsPolicy.validate("cors")...)
+	for prefix := range conf.CORS.Groups {
+		name := fmt.Sprintf("cors.groups[%s]", prefix)
+		if !strings.HasPrefix(prefix, "/") {
+			errs = append(errs, name+": want a path prefix")
+		}
+		errs = append(errs, conf.CORS.policy(prefix).validate(name)...)
+	}
+
+	if conf.Auth.CacheTTL < 0 {
+		errs = append(errs, "auth.cache_ttl: want >= 0")
+	}
+	if conf.Auth.TouchInterval < 0 {
+		errs = append(errs, "auth.touch_interval: want >= 0")
+	}
+
+	errs = append(errs, conf.Notify.validate()...)
+	errs = append(errs, conf.Webhooks.validate()...)
+
+	if conf.Mongo.URI != "" {
+		if _, err := mgo.ParseURL(conf.Mongo.URI); err != nil {
+			errs = append(errs, fmt.Sprintf("mongo.uri: %v", err))
+		}
+	} else {
+		if conf.Mongo.Host == "" {
+			errs = append(errs, "mongo.host is empty")
+		}
+		if _, err := strconv.Atoi(conf.Mongo.Port); err != nil {
+			errs = append(errs, fmt.Sprintf("mongo.port %q is not a number", conf.Mongo.Port))
+		}
+		if conf.Mongo.Database == "" {
+			errs = append(errs, "mongo.database is empty")
+		}
+	}
+	if conf.Mongo.Timeout <= 0 {
+		errs = append(errs, "mongo.timeout must be positive")
+	}
+	if conf.Mongo.PoolLimit < 0 {
+		errs = append(errs, "mongo.pool_limit must not be negative")
+	}
+	if conf.Mongo.PingInterval <= 0 {
+		errs = append(errs, "mongo.ping_interval must be positive")
+	}
+	if conf.Mongo.ReconnectMin <= 0 || conf.Mongo.ReconnectMax < conf.Mongo.ReconnectMin {
+		errs = append(errs, "mongo reconnect backoff: want 0 < reconnect_min <= reconnect_max")
+	}
+	if conf.Mongo.EventTTLAfterEnd < 0 || conf.Mongo.StdEventTTL < 0 {
+		errs = append(errs, "mongo event ttl must not be negative")
+	}
+	if conf.Mongo.EventExpire < 0 {
+		errs = append(errs, "mongo.event_expire must not be negative")
+	}
+	if conf.Mongo.AuditRetention < time.Second {
+		errs = append(errs, "mongo.audit_retention: want at least 1s")
+	}
+	if conf.Mongo.NotifyRetention < time.Second {
+		errs = append(errs, "mongo.notify_retention: want at least 1s")
+	}
+	if conf.Mongo.HookRetention < time.Second {
+		errs = append(errs, "mongo.hook_retention: want at least 1s")
+	}
+	if !contains(changeSources, conf.Mongo.Changes.Source) {
+		errs = append(errs, fmt.Sprintf("mongo.changes.source %q: want one of %s",
+			conf.Mongo.Changes.Source, strings.Join(changeSources, ", ")))
+	}
+	if conf.Mongo.Changes.Shards <= 0 {
+		errs = append(errs, "mongo.changes.shards: want > 0")
+	}
+	if p := conf.Mongo.Privacy; !contains(visibilities, p.Visibility) || !contains(precisions, p.Precision) {
+		errs = append(errs, fmt.Sprintf("mongo.privacy: want visibility one of %s and precision one of %s",
+			strings.Join(visibilities, ", "), strings.Join(precisions, ", ")))
+	}
+	if p := conf.Mongo.Privacy; p.GridSize < 10 || p.GeohashLength < 1 || p.GeohashLength > 12 {
+		errs = append(errs, "mongo.privacy: want grid_size >= 10 and geohash_length 1..12")
+	}
+
+	if len(errs) > 0 {
+		return errors.New("invalid config: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// dialInfo returns mgo dial options, the uri takes precedence over host/port
+func (mc *mongoConfig) dialInfo() (info *mgo.DialInfo, err error) {
+	if mc.URI != "" {
+		info, err = mgo.ParseURL(mc.URI)
+		if err != nil {
+			return nil, err
+		}
+		if info.Database == "" {
+			info.Database = mc.Database
+		}
+	} else {
+		info = &mgo.DialInfo{
+			Addrs:    []string{mc.Host + ":" + mc.Port},
+			Database: mc.Database,
+			Username: mc.Username,
+			Password: mc.Password,
+		}
+	}
+	if mc.Source != "" {
+		info.Source = mc.Source
+	}
+	info.Timeout = mc.Timeout
+	info.PoolLimit = mc.PoolLimit
+	return info, nil
+}
+
+// policy is the default policy with the overrides of one group applied
+func (conf *corsConfig) policy(group string) corsPolicy {
+	p := conf.corsPolicy
+	g, ok := conf.Groups[group]
+	if !ok {
+		return p
+	}
+	if len(g.Origins) > 0 {
+		p.Origins = g.Origins
+	}
+	if len(g.Methods) > 0 {
+		p.Methods = g.Methods
+	}
+	if len(g.Headers) > 0 {
+		p.Headers = g.Headers
+	}
+	if len(g.ExposedHeaders) > 0 {
+		p.ExposedHeaders = g.ExposedHeaders
+	}
+	if g.MaxAge != 0 {
+		p.MaxAge = g.MaxAge
+	}
+	p.Credentials = g.Credentials
+	return p
+}
+
+func (p corsPolicy) validate(name string) (errs []string) {
+	if len(p.Origins) == 0 {
+		errs = append(errs, name+".origins is empty")
+	}
+	for _, o := range p.Origins {
+		if err := checkOrigin(o); err != nil {
+			errs = append(errs, fmt.Sprintf("%s.origins %q: %v", name, o, err))
+		}
+	}
+	// browsers refuse credentials with "*", echoing any origin instead would
+	// hand every site the cookies of the user
+	if p.Credentials && contains(p.Origins, "*") {
+		errs = append(errs, name+".credentials: want explicit origins, not \"*\"")
+	}
+	for _, m := range p.Methods {
+		if m != strings.ToUpper(m) || strings.TrimSpace(m) != m || m == "" {
+			errs = append(errs, fmt.Sprintf("%s.methods %q: want an upper case method", name, m))
+		}
+	}
+	if p.MaxAge < 0 {
+		errs = append(errs, name+".max_age: want >= 0")
+	}
+	return errs
+}
+
+func (n *notifyConfig) validate() (errs []string) {
+	if len(n.Channels) == 0 {
+		errs = append(errs, "notify.channels is empty")
+	}
+	for _, ch := range n.Channels {
+		if !contains(notifyChannels, ch) {
+			errs = append(errs, fmt.Sprintf("notify.channels %q: want one of %s",
+				ch, strings.Join(notifyChannels, ", ")))
+		}
+	}
+	if contains(n.Channels, "file") && n.File == "" {
+		errs = append(errs, "notify.file is empty")
+	}
+	if contains(n.Channels, "webhook") && n.WebhookSecret == "" {
+		errs = append(errs, "notify.webhook_secret is empty")
+	}
+	if contains(n.Channels, "smtp") && (n.SMTP.Addr == "" || n.SMTP.From == "") {
+		errs = append(errs, "notify.smtp: want addr and from")
+	}
+	if n.QueueSize <= 0 || n.BatchSize <= 0 || n.MaxAttempts <= 0 {
+		errs = append(errs, "notify queue_size, batch_size and max_attempts must be positive")
+	}
+	if n.PollInterval <= 0 || n.Lease <= 0 || n.WebhookTimeout <= 0 {
+		errs = append(errs, "notify poll_interval, lease and webhook_timeout must be positive")
+	}
+	if n.BackoffMin <= 0 || n.BackoffMax < n.BackoffMin {
+		errs = append(errs, "notify backoff: want 0 < backoff_min <= backoff_max")
+	}
+	if n.MaxRadius <= 0 || n.FriendCooldown < 0 {
+		errs = append(errs, "notify: want max_radius > 0 and friend_cooldown >= 0")
+	}
+	return errs
+}
+
+func (w *webhookConfig) validate() (errs []string) {
+	if w.QueueSize <= 0 || w.BatchSize <= 0 || w.MaxAttempts <= 0 {
+		errs = append(errs, "webhooks queue_size, batch_size and max_attempts must be positive")
+	}
+	if w.PollInterval <= 0 || w.Lease <= 0 || w.Timeout <= 0 {
+		errs = append(errs, "webhooks poll_interval, lease and timeout must be positive")
+	}
+	if w.BackoffMin <= 0 || w.BackoffMax < w.BackoffMin {
+		errs = append(errs, "webhooks backoff: want 0 < backoff_min <= backoff_max")
+	}
+	return errs
+}
+
+func splitList(s string) (list []string) {
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
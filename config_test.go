@@ -0,0 +1,95 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geoloc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// case file then env
+	{
+		path := filepath.Join(dir, "geoloc.yaml")
+		data := []byte("listen: \":9000\"\nmongo:\n  database: \"file\"\n  timeout: \"3s\"\n")
+		err = ioutil.WriteFile(path, data, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Setenv("MONGO_NAME", "env")
+		defer os.Unsetenv("MONGO_NAME")
+
+		conf, err := loadConfig(path)
+		if err != nil {
+			t.Fatal("err loadConfig: ", err)
+		}
+		assert.Equal(t, ":9000", conf.Listen, "listen from file")
+		assert.Equal(t, "env", conf.Mongo.Database, "env should override file")
+		assert.Equal(t, 3*time.Second, conf.Mongo.Timeout, "timeout from file")
+		assert.Equal(t, 20*time.Minute, conf.Mongo.StdEventTTL, "default ttl")
+		assert.NoError(t, conf.validate())
+	}
+	// case toml
+	{
+		path := filepath.Join(dir, "geoloc.toml")
+		data := []byte("log_level = \"debug\"\n[cors]\norigins = [\"http://a.com\"]\n")
+		err = ioutil.WriteFile(path, data, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conf, err := loadConfig(path)
+		if err != nil {
+			t.Fatal("err loadConfig: ", err)
+		}
+		assert.Equal(t, "debug", conf.LogLevel)
+		assert.Equal(t, []string{"http://a.com"}, conf.CORS.Origins)
+	}
+	// case unknown field
+	{
+		path := filepath.Join(dir, "bad.yaml")
+		err = ioutil.WriteFile(path, []byte("lisen: \":9000\"\n"), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err := loadConfig(path)
+		assert.Error(t, err, "unknown field should fail")
+
+		path = filepath.Join(dir, "bad.toml")
+		err = ioutil.WriteFile(path, []byte("lisen = \":9000\"\n[mongo]\nhots = \"db\"\n"), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = loadConfig(path)
+		if assert.Error(t, err, "unknown key should fail") {
+			assert.Contains(t, err.Error(), "lisen")
+			assert.Contains(t, err.Error(), "mongo.hots")
+		}
+	}
+	// case invalid values
+	{
+		conf := defaultConfig()
+		conf.Listen = "8081"
+		conf.LogLevel = "verbose"
+		conf.Mongo.Port = "port"
+		conf.Mongo.Timeout = 0
+		conf.Server.TrustedProxies = []string{"10.0.0.0/8", "proxy"}
+		err := conf.validate()
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "listen")
+			assert.Contains(t, err.Error(), "log_level")
+			assert.Contains(t, err.Error(), "mongo.port")
+			assert.Contains(t, err.Error(), "mongo.timeout")
+			assert.Contains(t, err.Error(), `trusted_proxies "proxy"`)
+			assert.NotContains(t, err.Error(), "10.0.0.0/8")
+		}
+	}
+}
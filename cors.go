@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========== cors
+
+// corsRules is a corsPolicy with the header values joined once
+type corsRules struct {
+	corsPolicy
+	methods, headers, exposed, maxAge string
+}
+
+func newCORSRules(p corsPolicy) *corsRules {
+	return &corsRules{
+		corsPolicy: p,
+		methods:    strings.Join(p.Methods, ", "),
+		headers:    strings.Join(p.Headers, ", "),
+		exposed:    strings.Join(p.ExposedHeaders, ", "),
+		maxAge:     strconv.Itoa(int(p.MaxAge.Seconds())),
+	}
+}
+
+// checkOrigin accepts "*", "null" and scheme://host[:port] where the host
+// may start with a "*." wildcard
+func checkOrigin(o string) error {
+	if o == "*" || o == "null" {
+		return nil
+	}
+	i := strings.Index(o, "://")
+	if i <= 0 || strings.ContainsAny(o[i+3:], "/?#") || o[i+3:] == "" {
+		return errors.New("want scheme://host[:port]")
+	}
+	if _, err := path.Match(o, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// allowOrigin matches the Origin header, a "*" in a pattern stands for
+// one or more host labels
+func (r *corsRules) allowOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, o := range r.Origins {
+		if o == "*" {
+			return true
+		}
+		if ok, _ := path.Match(strings.ToLower(o), origin); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// corsGroup is the longest group prefix of the request path, "" for the
+// default policy
+func corsGroup(conf *corsConfig, p string) string {
+	group := ""
+	for prefix := range conf.Groups {
+		if len(prefix) > len(group) &&
+			(p == prefix || strings.HasPrefix(p, strings.TrimSuffix(prefix, "/")+"/")) {
+			group = prefix
+		}
+	}
+	return group
+}
+
+// middlewareCORS applies the policy of the route group to the request and
+// answers preflights itself, so they never reach the db or the limiter
+func middlewareCORS(conf *corsConfig) gin.HandlerFunc {
+	rules := map[string]*corsRules{"": newCORSRules(conf.corsPolicy)}
+	for prefix := range conf.Groups {
+		rules[prefix] = newCORSRules(conf.policy(prefix))
+	}
+
+	return func(c *gin.Context) {
+		r := rules[corsGroup(conf, c.Request.URL.Path)]
+		h := c.Writer.Header()
+		preflight := c.Request.Method == http.MethodOptions
+
+		anyOrigin := contains(r.Origins, "*")
+		if !anyOrigin {
+			h.Add("Vary", "Origin")
+		}
+		if preflight {
+			h.Add("Vary", "Access-Control-Request-Method")
+			h.Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		origin := c.GetHeader("Origin")
+		if origin != "" && r.allowOrigin(origin) {
+			if anyOrigin {
+				h.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				h.Set("Access-Control-Allow-Origin", origin)
+			}
+			if r.Credentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+			if preflight {
+				h.Set("Access-Control-Allow-Methods", r.methods)
+				headers := r.headers
+				if contains(r.Headers, "*") {
+					headers = c.GetHeader("Access-Control-Request-Headers")
+				}
+				if headers != "" {
+					h.Set("Access-Control-Allow-Headers", headers)
+				}
+				if r.MaxAge > 0 {
+					h.Set("Access-Control-Max-Age", r.maxAge)
+				}
+			} else if r.exposed != "" {
+				h.Set("Access-Control-Expose-Headers", r.exposed)
+			}
+		}
+
+		if preflight {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+		c.Next()
+	}
+}
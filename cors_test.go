@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORS(t *testing.T) {
+	conf := defaultConfig()
+	conf.CORS.Origins = []string{"https://*.example.com", "http://localhost:3000"}
+	conf.CORS.Groups = map[string]corsPolicy{
+		"/api/v1/users": {Origins: []string{"https://app.example.com"},
+			Credentials: true, MaxAge: time.Minute},
+	}
+	assert.NoError(t, conf.validate())
+
+	db := &mongoDB{}
+	// preflights are answered while the db is down
+	db.health.set(errors.New("no reachable servers"))
+	eng := newTestRouter(conf, db)
+
+	do := func(method, url, origin string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(method, url, nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if method == "OPTIONS" {
+			req.Header.Set("Access-Control-Request-Method", "DELETE")
+		}
+		response := httptest.NewRecorder()
+		eng.ServeHTTP(response, req)
+		return response
+	}
+
+	res := do("OPTIONS", "/api/v1/locs/abc", "https://maps.example.com")
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	assert.Equal(t, "https://maps.example.com", res.Header().Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, res.Header().Get("Access-Control-Allow-Methods"), "DELETE")
+	assert.Contains(t, res.Header().Get("Access-Control-Allow-Headers"), "If-Match")
+	assert.Equal(t, "600", res.Header().Get("Access-Control-Max-Age"))
+	assert.Empty(t, res.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Contains(t, res.Header().Values("Vary"), "Origin")
+
+	// wildcards need a label, other origins get no headers
+	res = do("OPTIONS", "/api/v1/locs", "https://example.com")
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	assert.Empty(t, res.Header().Get("Access-Control-Allow-Origin"))
+
+	// the group override
+	res = do("OPTIONS", "/api/v1/users/abc", "https://app.example.com")
+	assert.Equal(t, "true", res.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(t, "60", res.Header().Get("Access-Control-Max-Age"))
+	res = do("OPTIONS", "/api/v1/users", "https://maps.example.com")
+	assert.Empty(t, res.Header().Get("Access-Control-Allow-Origin"))
+
+	// simple requests expose headers and keep their own content type
+	res = do("GET", "/api/v1/openapi.json", "http://localhost:3000")
+	assert.Equal(t, "http://localhost:3000", res.Header().Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, res.Header().Get("Access-Control-Expose-Headers"), "ETag")
+	res = do("GET", "/api/v1/locs/all", "http://localhost:3000")
+	assert.Equal(t, problemContentType, res.Header().Get("Content-Type"))
+
+	// credentials can't go with any origin
+	conf.CORS.Groups["/api/v1/users"] = corsPolicy{Origins: []string{"*"}, Credentials: true}
+	assert.Error(t, conf.validate())
+	conf.CORS.Groups = nil
+	conf.CORS.Origins = []string{"example.com/x"}
+	assert.Error(t, conf.validate())
+}
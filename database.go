@@ -2,13 +2,16 @@ package main
 
 import (
 	// "errors"
+	"context"
 	"fmt"
-	"os"
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
 	"time"
 
 	mgo "gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
-	"strings"
 )
 
 // ========== database init
@@ -22,32 +25,46 @@ type mongoDB struct {
 	Password         string
 	EventTTLAfterEnd time.Duration
 	StdEventTTL      time.Duration
+	EventExpire      time.Duration
+	AuditRetention   time.Duration
+	NotifyRetention  time.Duration
+	HookRetention    time.Duration
+	Privacy          privacyConfig
 	Info             *mgo.DialInfo
 	Session          *mgo.Session
-}
-
-func (mongo *mongoDB) setDefault() {
-	mongo.Port = os.Getenv("MONGO_PORT")
-	mongo.Host = os.Getenv("MONGO_HOST")
-	mongo.Addrs = mongo.Host + ":" + mongo.Port
-	mongo.Database = os.Getenv("MONGO_NAME")
-	mongo.Username = os.Getenv("MONGO_USER")
-	mongo.Password = os.Getenv("MONGO_PASSWORD")
 
-	mongo.EventTTLAfterEnd = 1 * time.Second
-	mongo.StdEventTTL = 20 * time.Minute
+	health dbHealth
+	// bus has the changes of users, events and locations
+	bus *bus
+}
 
-	mongo.Info = &mgo.DialInfo{
-		Addrs:    []string{mongo.Addrs},
-		Timeout:  10 * time.Second,
-		Database: mongo.Database,
-		Username: mongo.Username,
-		Password: mongo.Password,
+func (mongo *mongoDB) setConfig(conf *mongoConfig) (err error) {
+	mongo.Info, err = conf.dialInfo()
+	if err != nil {
+		return err
 	}
-	err := mongo.setSession()
+
+	mongo.Host = conf.Host
+	mongo.Port = conf.Port
+	mongo.Addrs = strings.Join(mongo.Info.Addrs, ",")
+	mongo.Database = mongo.Info.Database
+	mongo.Username = mongo.Info.Username
+	mongo.Password = mongo.Info.Password
+
+	mongo.EventTTLAfterEnd = conf.EventTTLAfterEnd
+	mongo.StdEventTTL = conf.StdEventTTL
+	mongo.EventExpire = conf.EventExpire
+	mongo.AuditRetention = conf.AuditRetention
+	mongo.NotifyRetention = conf.NotifyRetention
+	mongo.HookRetention = conf.HookRetention
+	mongo.Privacy = conf.Privacy
+	mongo.bus = newBus(&conf.Changes)
+
+	err = mongo.setSession()
 	if err != nil {
-		panic("db connection does not exist")
+		return fmt.Errorf("db connection to %s: %v", mongo.Addrs, err)
 	}
+	return nil
 }
 
 func (mongo *mongoDB) setSession() (err error) {
@@ -58,120 +75,1300 @@ func (mongo *mongoDB) setSession() (err error) {
 	return err
 }
 
+// clone returns a copy of the main session for a single operation
+func (mongo *mongoDB) clone() *mgo.Session {
+	metrics.sessionClones.Inc()
+	return mongo.Session.Clone()
+}
+
+// keepAlive pings the db, caches the result and the schema checks for health checks and refreshes the session with
+// backoff when it drops, so requests recover once mongo is back instead of failing on a dead socket. The first probe
+// runs right away.
+func (mongo *mongoDB) keepAlive(ctx context.Context, conf *mongoConfig) {
+	wait := time.Duration(0)
+	backoff := time.Duration(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		err := mongo.Session.Ping()
+		mongo.health.set(err)
+		if err == nil {
+			mongo.checkSchema()
+			if backoff != 0 {
+				slog.Info("db connection restored")
+			}
+			backoff = 0
+			wait = conf.PingInterval
+			continue
+		}
+
+		mongo.Session.Refresh()
+		if backoff == 0 {
+			backoff = conf.ReconnectMin
+		} else if backoff *= 2; backoff > conf.ReconnectMax {
+			backoff = conf.ReconnectMax
+		}
+		wait = backoff
+		slog.Warn("db ping failed", "error", err, "retry_in", wait.String())
+	}
+}
+
 func (mongo *mongoDB) drop() {
-	session := mongo.Session.Clone()
+	session := mongo.clone()
 	defer session.Close()
-	session.DB(mongo.Database).C("dviUsers").DropCollection()
-	session.DB(mongo.Database).C("dviEvents").DropCollection()
-	session.DB(mongo.Database).C("dviLocations").DropCollection()
+	for _, name := range []string{"dviUsers", "dviEvents", "dviLocations", "dviKeys",
+		"dviReports", "dviAudit", "dviRelations", "dviNotifications", "dviWebhooks",
+		"dviDeliveries", "schema_migrations"} {
+		session.DB(mongo.Database).C(name).DropCollection()
+	}
+}
+
+// dbIndex is an index the service relies on
+type dbIndex struct {
+	Collection string
+	Index      mgo.Index
 }
 
-func (mongo *mongoDB) init() (err error) {
+func (mongo *mongoDB) indexes() []dbIndex {
+	return []dbIndex{
+		// ========== users
+		{"dviUsers", mgo.Index{
+			Key:        []string{"name", "email", "text", "events"},
+			Unique:     false,
+			Background: true,
+			Sparse:     true,
+		}},
+		// ========== events
+		{"dviEvents", mgo.Index{
+			Key:        []string{"name", "text", "users", "timestamp"},
+			Unique:     false,
+			Background: true,
+			Sparse:     true,
+		}},
+		{"dviEvents", mgo.Index{
+			Key:         []string{"ttl"},
+			ExpireAfter: mongo.EventExpire,
+		}},
+		{"dviEvents", mgo.Index{
+			Key:    []string{"external_id"},
+			Unique: true,
+			Sparse: true,
+		}},
+		// ========== locations
+		{"dviLocations", mgo.Index{
+			Key:  []string{"$2dsphere:location"},
+			Bits: 26,
+		}},
+		{"dviLocations", mgo.Index{
+			Key:    []string{"external_id"},
+			Unique: true,
+			Sparse: true,
+		}},
+		// ========== moderation
+		{"dviReports", mgo.Index{
+			Key: []string{"target", "resolved_at"},
+		}},
+		{"dviReports", mgo.Index{
+			Key: []string{"kind", "created_at"},
+		}},
+		// ========== audit
+		{"dviAudit", mgo.Index{
+			Key:         []string{"at"},
+			ExpireAfter: mongo.AuditRetention,
+		}},
+		{"dviAudit", mgo.Index{
+			Key: []string{"kind", "entity", "-at"},
+		}},
+		{"dviAudit", mgo.Index{
+			Key: []string{"actor", "-at"},
+		}},
+		// ========== relations
+		{"dviRelations", mgo.Index{
+			Key:    []string{"kind", "from", "to"},
+			Unique: true,
+		}},
+		{"dviRelations", mgo.Index{
+			Key: []string{"kind", "to", "status"},
+		}},
+		{"dviRelations", mgo.Index{
+			Key:    []string{"pair"},
+			Unique: true,
+			Sparse: true,
+		}},
+		// ========== notifications
+		{"dviNotifications", mgo.Index{
+			Key:    []string{"key"},
+			Unique: true,
+		}},
+		{"dviNotifications", mgo.Index{
+			Key: []string{"status", "next_at"},
+		}},
+		{"dviNotifications", mgo.Index{
+			Key: []string{"user", "-created_at"},
+		}},
+		{"dviNotifications", mgo.Index{
+			Key:         []string{"created_at"},
+			ExpireAfter: mongo.NotifyRetention,
+		}},
+		// ========== webhooks
+		{"dviDeliveries", mgo.Index{
+			Key:    []string{"hook", "event"},
+			Unique: true,
+		}},
+		{"dviDeliveries", mgo.Index{
+			Key: []string{"status", "next_at"},
+		}},
+		{"dviDeliveries", mgo.Index{
+			Key: []string{"hook", "status", "-created_at"},
+		}},
+		// dead deliveries have no delivered_at and stay until replayed
+		{"dviDeliveries", mgo.Index{
+			Key:         []string{"delivered_at"},
+			ExpireAfter: mongo.HookRetention,
+		}},
+		// ========== api keys
+		{"dviKeys", mgo.Index{
+			Key:    []string{"prefix"},
+			Unique: true,
+		}},
+	}
+}
+
+// reset drops the data and migrates the db from scratch
+func (mongo *mongoDB) reset(out io.Writer) error {
 	mongo.drop()
+	return mongo.migrate(out, false)
+}
 
-	session := mongo.Session.Clone()
+// ensureIndex creates idx, a ttl index that exists with another expiry is
+// changed in place, so a new retention applies to the kept collections
+func ensureIndex(db *mgo.Database, idx dbIndex) error {
+	err := db.C(idx.Collection).EnsureIndex(idx.Index)
+	qerr, ok := err.(*mgo.QueryError)
+	if !ok || qerr.Code != 85 || idx.Index.ExpireAfter == 0 {
+		return err
+	}
+	key := bson.D{}
+	for _, k := range idx.Index.Key {
+		key = append(key, bson.DocElem{Name: k, Value: 1})
+	}
+	return db.Run(bson.D{
+		{Name: "collMod", Value: idx.Collection},
+		{Name: "index", Value: bson.M{
+			"keyPattern":         key,
+			"expireAfterSeconds": int(idx.Index.ExpireAfter / time.Second),
+		}},
+	}, nil)
+}
+
+// missingIndexes returns the indexes from indexes() absent in the db
+func (mongo *mongoDB) missingIndexes() (missing []dbIndex, err error) {
+	session := mongo.clone()
 	defer session.Close()
-	session.EnsureSafe(&mgo.Safe{})
 
-	// ========== users
-	collection := session.DB(mongo.Database).C("dviUsers")
-	index := mgo.Index{
-		Key:        []string{"name", "email", "text", "events"},
-		Unique:     false,
-		Background: true,
-		Sparse:     true,
+	present := map[string][]mgo.Index{}
+	for _, idx := range mongo.indexes() {
+		if _, ok := present[idx.Collection]; ok {
+			continue
+		}
+		present[idx.Collection], err = session.DB(mongo.Database).C(idx.Collection).Indexes()
+		if err != nil && !isNsNotFound(err) {
+			return nil, err
+		}
 	}
-	err = collection.EnsureIndex(index)
-	if err != nil {
-		return err
+
+	for _, idx := range mongo.indexes() {
+		found := false
+		for _, index := range present[idx.Collection] {
+			if strings.Join(index.Key, ",") == strings.Join(idx.Index.Key, ",") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, idx)
+		}
+	}
+	return missing, nil
+}
+
+// isNsNotFound reports a query against a collection that does not exist yet
+func isNsNotFound(err error) bool {
+	qerr, ok := err.(*mgo.QueryError)
+	return ok && qerr.Code == 26
+}
+
+// ========== versions
+
+// protectedFields are written by the admin api only, a replace keeps the
+// stored ones whatever the doc says
+var protectedFields = []string{"role", "moderation"}
+
+// replaceVersioned replaces document id in c and bumps its version. A non zero
+// *version must be the stored one, zero replaces whatever is stored.
+func replaceVersioned(c *mgo.Collection, id bson.ObjectId, version *int64, doc interface{}) (err error) {
+	expect := *version
+	sel := bson.M{"version": 1}
+	for _, f := range protectedFields {
+		sel[f] = 1
 	}
+	for attempt := 0; attempt < 3; attempt++ {
+		// moderation bumps the version, so protected members read here are
+		// still the stored ones when the versioned update matches
+		var stored struct {
+			Version   int64  `bson:"version"`
+			Protected bson.M `bson:",inline"`
+		}
+		err = c.FindId(id).Select(sel).One(&stored)
+		if err != nil {
+			return err
+		}
+		current := expect
+		if expect == 0 {
+			current = stored.Version
+		}
+
+		*version = current + 1
+		replace := bson.M{}
+		data, err := bson.Marshal(doc)
+		if err == nil {
+			err = bson.Unmarshal(data, &replace)
+		}
+		if err != nil {
+			*version = expect
+			return err
+		}
+		for _, f := range protectedFields {
+			if v, ok := stored.Protected[f]; ok {
+				replace[f] = v
+			} else {
+				delete(replace, f)
+			}
+		}
+
+		err = c.Update(bson.M{"_id": id, "version": versionQuery(current)}, replace)
+		if err != mgo.ErrNotFound {
+			if err != nil {
+				*version = expect
+			}
+			return err
+		}
+		*version = expect
 
-	// ========== events
-	collection = session.DB(mongo.Database).C("dviEvents")
-	index = mgo.Index{
-		Key:        []string{"name", "text", "users", "timestamp"},
-		Unique:     false,
-		Background: true,
-		Sparse:     true,
+		if expect != 0 {
+			return errVersionMismatch
+		}
+		// a blind replace raced with another write, read the version again
 	}
-	err = collection.EnsureIndex(index)
+	return errVersionMismatch
+}
+
+// versionQuery matches documents written before versions existed as 0
+func versionQuery(version int64) interface{} {
+	if version == 0 {
+		return bson.M{"$in": []interface{}{0, nil}}
+	}
+	return version
+}
+
+// ========== audit
+
+// collKinds names the documents of a collection in the audit log
+var collKinds = map[string]string{
+	"dviUsers":     "user",
+	"dviEvents":    "event",
+	"dviLocations": "location",
+}
+
+// replaceAudited is replaceVersioned that records the change
+func (mongo *mongoDB) replaceAudited(ctx context.Context, c *mgo.Collection, id bson.ObjectId, version *int64, doc interface{}) (err error) {
+	before := bson.M{}
+	err = c.FindId(id).One(&before)
 	if err != nil {
 		return err
 	}
-	index = mgo.Index{
-		Key:         []string{"ttl"},
-		ExpireAfter: time.Duration(30) * time.Second,
+	err = replaceVersioned(c, id, version, doc)
+	if err != nil {
+		return err
 	}
-	err = collection.EnsureIndex(index)
+	mongo.publish(ctx, newChange(ctx, collKinds[c.Name], "update", id, before, doc))
+	return nil
+}
+
+// removeAudited removes id from c and records what it was
+func (mongo *mongoDB) removeAudited(ctx context.Context, c *mgo.Collection, id bson.ObjectId) (err error) {
+	before := bson.M{}
+	_, err = c.FindId(id).Apply(mgo.Change{Remove: true}, &before)
 	if err != nil {
 		return err
 	}
+	mongo.publish(ctx, newChange(ctx, collKinds[c.Name], "delete", id, before, nil))
+	return nil
+}
 
-	// ========== locations
-	collection = session.DB(mongo.Database).C("dviLocations")
-	index = mgo.Index{
-		Key:  []string{"$2dsphere:location"},
-		Bits: 26,
+// publishBulk publishes the documents of a bulk write that were written
+func (mongo *mongoDB) publishBulk(ctx context.Context, kind string, docs []bulkDoc, upsert bool) {
+	action := "create"
+	if upsert {
+		action = "upsert"
 	}
-	err = collection.EnsureIndex(index)
-	if err != nil {
+	changes := []change{}
+	for _, doc := range docs {
+		if doc.Err != nil || doc.ID == "" {
+			continue
+		}
+		if upsert {
+			changes = append(changes, newChange(ctx, kind, action, doc.ID, doc.Before, doc.After))
+		} else {
+			changes = append(changes, newChange(ctx, kind, action, doc.ID, nil, doc.Doc))
+		}
+	}
+	mongo.publish(ctx, changes...)
+}
+
+func (mongo *mongoDB) postAudit(ctx context.Context, entries []auditEntry) (err error) {
+	_, end := traceDB(ctx, "postAudit")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	docs := make([]interface{}, len(entries))
+	for i := range entries {
+		docs[i] = &entries[i]
+	}
+	err = session.DB(mongo.Database).C("dviAudit").Insert(docs...)
+	return err
+}
+
+// getAudit is the audit log of an entity or an actor, newest first
+func (mongo *mongoDB) getAudit(ctx context.Context, q *reqAudit) (entries []auditEntry, err error) {
+	_, end := traceDB(ctx, "getAudit")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	query := bson.M{}
+	if q.Kind != "" {
+		query["kind"] = q.Kind
+	}
+	if q.Entity != "" {
+		query["entity"] = bson.ObjectIdHex(q.Entity)
+	}
+	if q.Actor != "" {
+		query["actor"] = q.Actor
+	}
+	at := bson.M{}
+	if !q.Since.IsZero() {
+		at["$gte"] = q.Since
+	}
+	if !q.Until.IsZero() {
+		at["$lt"] = q.Until
+	}
+	if len(at) > 0 {
+		query["at"] = at
+	}
+	err = session.DB(mongo.Database).C("dviAudit").Find(query).
+		Sort("-at").Limit(q.Limit).All(&entries)
+	return entries, err
+}
+
+// ========== bulk
+
+var errNoExternalID = newAPIError(kindValidation, "external_id_required",
+	"upsert needs an external_id", nil)
+
+// bulkDoc is one document of a bulk write, ID and Err are set by the write
+type bulkDoc struct {
+	ID         bson.ObjectId
+	ExternalID string
+	Doc        interface{}
+	Err        error
+	// Before and After are the stored doc around an upsert, Before is nil
+	// when it inserted
+	Before bson.M
+	After  bson.M
+}
+
+// bulkWrite runs docs as one unordered bulk, inserting them or upserting them
+// by external_id. Upserts keep the stored members the doc leaves empty.
+func bulkWrite(c *mgo.Collection, docs []bulkDoc, upsert bool) (err error) {
+	bulk := c.Bulk()
+	bulk.Unordered()
+	queued := []int{}
+	for i := range docs {
+		if !upsert {
+			bulk.Insert(docs[i].Doc)
+			queued = append(queued, i)
+			continue
+		}
+		if docs[i].ExternalID == "" {
+			docs[i].Err = errNoExternalID
+			continue
+		}
+		set := bson.M{}
+		data, err := bson.Marshal(docs[i].Doc)
+		if err == nil {
+			err = bson.Unmarshal(data, &set)
+		}
+		if err != nil {
+			docs[i].Err = err
+			continue
+		}
+		delete(set, "_id")
+		delete(set, "version")
+		for _, f := range protectedFields {
+			delete(set, f)
+		}
+		bulk.Upsert(
+			bson.M{"external_id": docs[i].ExternalID},
+			bson.M{
+				"$set":         set,
+				"$setOnInsert": bson.M{"_id": bson.NewObjectId()},
+				"$inc":         bson.M{"version": 1},
+			},
+		)
+		queued = append(queued, i)
+	}
+	if len(queued) == 0 {
+		return nil
+	}
+
+	// the docs the upserts update, read ahead for the audit log
+	extIDs := []string{}
+	for _, i := range queued {
+		extIDs = append(extIDs, docs[i].ExternalID)
+	}
+	before := map[string]bson.M{}
+	if upsert {
+		before, err = byExternalID(c, extIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = bulk.Run()
+	if berr, ok := err.(*mgo.BulkError); ok {
+		for _, cs := range berr.Cases() {
+			if cs.Index < 0 {
+				// servers before 2.6 don't say which insert failed
+				return cs.Err
+			}
+			docs[queued[cs.Index]].Err = cs.Err
+		}
+		err = nil
+	}
+	if err != nil || !upsert {
+		for i := range docs {
+			if docs[i].Err != nil {
+				docs[i].ID = ""
+			}
+		}
 		return err
 	}
 
+	// upserts don't report ids, look them up by external id
+	after, err := byExternalID(c, extIDs)
+	if err != nil {
+		return err
+	}
+	for _, i := range queued {
+		if docs[i].Err == nil {
+			ext := docs[i].ExternalID
+			docs[i].ID, _ = after[ext]["_id"].(bson.ObjectId)
+			docs[i].Before, docs[i].After = before[ext], after[ext]
+		}
+	}
 	return nil
 }
 
+// byExternalID reads the docs of c with one of extIDs by external id
+func byExternalID(c *mgo.Collection, extIDs []string) (docs map[string]bson.M, err error) {
+	var stored []bson.M
+	err = c.Find(bson.M{"external_id": bson.M{"$in": extIDs}}).All(&stored)
+	docs = map[string]bson.M{}
+	for _, doc := range stored {
+		ext, _ := doc["external_id"].(string)
+		docs[ext] = doc
+	}
+	return docs, err
+}
+
+// ========== moderation
+
+// visible leaves out hidden and suspended documents, a location shares the
+// id of its event or user and moderate applies to it too
+func visible(query bson.M) bson.M {
+	query["moderation.hidden"] = bson.M{"$ne": true}
+	query["moderation.suspended"] = bson.M{"$ne": true}
+	return query
+}
+
+// visibleTo is visible for the viewer of ctx, staff see what they moderate
+func visibleTo(ctx context.Context, query bson.M) bson.M {
+	if viewerFrom(ctx).staff() {
+		return query
+	}
+	return visible(query)
+}
+
+// moderate sets or, with a nil mod, clears the moderation of id in the
+// collections of target and resolves the open reports on it. The first
+// collection must hold id, the others are the location that shares it.
+func (mongo *mongoDB) moderate(ctx context.Context, target moderationTarget, id bson.ObjectId, mod *moderation, action string) (doc bson.M, err error) {
+	_, end := traceDB(ctx, "moderate")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	update := bson.M{"$unset": bson.M{"moderation": ""}, "$inc": bson.M{"version": 1}}
+	if mod != nil {
+		update = bson.M{"$set": bson.M{"moderation": mod}, "$inc": bson.M{"version": 1}}
+	}
+	changes := []change{}
+	for i, coll := range target.colls {
+		c := session.DB(mongo.Database).C(coll)
+		before, after := bson.M{}, bson.M{}
+		_, err = c.FindId(id).Apply(mgo.Change{Update: update}, &before)
+		if err == mgo.ErrNotFound && i > 0 {
+			continue
+		}
+		if err == nil {
+			err = c.FindId(id).One(&after)
+		}
+		if err != nil {
+			mongo.publish(ctx, changes...)
+			return doc, err
+		}
+		if i == 0 {
+			doc = after
+		}
+		changes = append(changes, newChange(ctx, collKinds[coll], action, id, before, after))
+	}
+	mongo.publish(ctx, changes...)
+
+	_, err = session.DB(mongo.Database).C("dviReports").UpdateAll(bson.M{
+		"target":      id,
+		"resolved_at": bson.M{"$exists": false},
+	}, bson.M{"$set": bson.M{
+		"resolved_at": time.Now(),
+		"resolution":  action,
+		"resolved_by": auditFrom(ctx).Actor,
+	}})
+	return doc, err
+}
+
+func (mongo *mongoDB) setRole(ctx context.Context, id bson.ObjectId, role string) (user geoUser, err error) {
+	_, end := traceDB(ctx, "setRole")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	c := session.DB(mongo.Database).C("dviUsers")
+	before := bson.M{}
+	_, err = c.FindId(id).Apply(mgo.Change{
+		Update: bson.M{"$set": bson.M{"role": role}, "$inc": bson.M{"version": 1}},
+	}, &before)
+	if err != nil {
+		return user, err
+	}
+	err = c.FindId(id).One(&user)
+	if err != nil {
+		return user, err
+	}
+	mongo.publish(ctx, newChange(ctx, "user", "role", id, before, &user))
+	return user, nil
+}
+
+func (mongo *mongoDB) postReport(ctx context.Context, r *report) (err error) {
+	_, end := traceDB(ctx, "postReport")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	r.ID = bson.NewObjectId()
+	err = session.DB(mongo.Database).C("dviReports").Insert(r)
+	return err
+}
+
+// getReports is the moderation queue, oldest first
+func (mongo *mongoDB) getReports(ctx context.Context, q *reqQueue) (reports []report, err error) {
+	_, end := traceDB(ctx, "getReports")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	query := bson.M{"resolved_at": bson.M{"$exists": q.Resolved}}
+	if q.Kind != "" {
+		query["kind"] = q.Kind
+	}
+	err = session.DB(mongo.Database).C("dviReports").Find(query).
+		Sort("created_at").Limit(q.Limit).All(&reports)
+	return reports, err
+}
+
+// ========== relations
+
+// follow makes from follow to, following again changes nothing
+func (mongo *mongoDB) follow(ctx context.Context, from, to bson.ObjectId) (rel relation, err error) {
+	_, end := traceDB(ctx, "follow")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	_, err = session.DB(mongo.Database).C("dviRelations").Find(bson.M{
+		"kind": "follow", "from": from, "to": to,
+	}).Apply(mgo.Change{
+		Update: bson.M{"$setOnInsert": bson.M{
+			"_id": bson.NewObjectId(), "status": "accepted", "created_at": time.Now(),
+		}},
+		Upsert:    true,
+		ReturnNew: true,
+	}, &rel)
+	return rel, err
+}
+
+// befriend sends a friend request from from to to, or accepts the one to
+// sent from. A request already sent or accepted is returned as it is. The
+// unique pair makes requests the two send at once meet in one document, the
+// later one then accepts it.
+func (mongo *mongoDB) befriend(ctx context.Context, from, to bson.ObjectId) (rel relation, err error) {
+	_, end := traceDB(ctx, "befriend")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+	c := session.DB(mongo.Database).C("dviRelations")
+
+	err = c.Find(friendship(from, to)).One(&rel)
+	if err == mgo.ErrNotFound {
+		rel = relation{ID: bson.NewObjectId(), Kind: "friend", From: from, To: to,
+			Status: "pending", CreatedAt: time.Now(), Pair: friendPair(from, to)}
+		err = c.Insert(&rel)
+		if !mgo.IsDup(err) {
+			return rel, err
+		}
+		// a request between them in another call, from either of them
+		err = c.Find(friendship(from, to)).One(&rel)
+	}
+	if err != nil || rel.Status != "pending" || rel.To != from {
+		return rel, err
+	}
+	_, err = c.Find(bson.M{"_id": rel.ID, "status": "pending"}).Apply(mgo.Change{
+		Update:    bson.M{"$set": bson.M{"status": "accepted", "accepted_at": time.Now()}},
+		ReturnNew: true,
+	}, &rel)
+	if err == mgo.ErrNotFound {
+		// accepted by another call, or withdrawn since
+		err = c.FindId(rel.ID).One(&rel)
+	}
+	return rel, err
+}
+
+// unrelate removes a follow of to by from, or a friendship or request
+// between them in either direction
+func (mongo *mongoDB) unrelate(ctx context.Context, kind string, from, to bson.ObjectId) (err error) {
+	_, end := traceDB(ctx, "unrelate")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	query := bson.M{"kind": "follow", "from": from, "to": to}
+	if kind == "friend" {
+		query = friendship(from, to)
+	}
+	return session.DB(mongo.Database).C("dviRelations").Remove(query)
+}
+
+// friendship is the friend relation between a and b, whoever sent it
+func friendship(a, b bson.ObjectId) bson.M {
+	return bson.M{"kind": "friend", "pair": friendPair(a, b)}
+}
+
+// friendPair is the pair of a friend relation between a and b, the same
+// whichever of them sent it
+func friendPair(a, b bson.ObjectId) string {
+	if a.Hex() > b.Hex() {
+		a, b = b, a
+	}
+	return a.Hex() + ":" + b.Hex()
+}
+
+// relationQueries are the lists of GET /users/:id/<list>
+var relationQueries = map[string]func(id bson.ObjectId) bson.M{
+	"followers": func(id bson.ObjectId) bson.M { return bson.M{"kind": "follow", "to": id} },
+	"following": func(id bson.ObjectId) bson.M { return bson.M{"kind": "follow", "from": id} },
+	"friends": func(id bson.ObjectId) bson.M {
+		return bson.M{"kind": "friend", "status": "accepted",
+			"$or": []bson.M{{"from": id}, {"to": id}}}
+	},
+	"requests": func(id bson.ObjectId) bson.M {
+		return bson.M{"kind": "friend", "status": "pending", "to": id}
+	},
+}
+
+// getRelations is a list of relationQueries for id, newest first
+func (mongo *mongoDB) getRelations(ctx context.Context, list string, id bson.ObjectId, q *reqRelations) (rels []relation, err error) {
+	_, end := traceDB(ctx, "getRelations")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	rels = []relation{}
+	err = session.DB(mongo.Database).C("dviRelations").Find(relationQueries[list](id)).
+		Sort("-created_at").Skip(q.Skip).Limit(q.Limit).All(&rels)
+	return rels, err
+}
+
+// friendIDs is the friends of user, among the ids when they are given
+func (mongo *mongoDB) friendIDs(ctx context.Context, user bson.ObjectId, among []bson.ObjectId) (friends map[bson.ObjectId]bool, err error) {
+	_, end := traceDB(ctx, "friendIDs")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	from, to := bson.M{"from": user}, bson.M{"to": user}
+	if among != nil {
+		from["to"] = bson.M{"$in": among}
+		to["from"] = bson.M{"$in": among}
+	}
+	rels := []relation{}
+	err = session.DB(mongo.Database).C("dviRelations").Find(bson.M{
+		"kind": "friend", "status": "accepted", "$or": []bson.M{from, to},
+	}).Select(bson.M{"from": 1, "to": 1}).All(&rels)
+
+	friends = map[bson.ObjectId]bool{}
+	for _, r := range rels {
+		if r.From == user {
+			friends[r.To] = true
+		} else {
+			friends[r.From] = true
+		}
+	}
+	return friends, err
+}
+
+// ========== notifications
+
+// getUsersNear is the visible users with pref set whose location is within
+// radius of at, with their locations
+func (mongo *mongoDB) getUsersNear(ctx context.Context, at geoObject, radius float64, pref string) (users []geoUser, locs map[bson.ObjectId]geoLocation, err error) {
+	_, end := traceDB(ctx, "getUsersNear")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	near := []geoLocation{}
+	err = session.DB(mongo.Database).C("dviLocations").Find(visible(bson.M{
+		"tobject": "User",
+		"location": bson.M{
+			"$nearSphere": bson.M{
+				"$geometry":    at,
+				"$maxDistance": radius,
+			},
+		},
+	})).All(&near)
+	if err != nil || len(near) == 0 {
+		return nil, nil, err
+	}
+	ids := make([]bson.ObjectId, len(near))
+	for i, l := range near {
+		ids[i] = l.ID
+	}
+	return mongo.usersWithLocs(session, ids, near, pref)
+}
+
+// getUsersByIDWithLocs is the visible users among ids with pref set and a
+// location, with their locations
+func (mongo *mongoDB) getUsersByIDWithLocs(ctx context.Context, ids []bson.ObjectId, pref string) (users []geoUser, locs map[bson.ObjectId]geoLocation, err error) {
+	_, end := traceDB(ctx, "getUsersByIDWithLocs")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	found := []geoLocation{}
+	err = session.DB(mongo.Database).C("dviLocations").Find(visible(bson.M{
+		"_id": bson.M{"$in": ids}, "tobject": "User",
+	})).All(&found)
+	if err != nil || len(found) == 0 {
+		return nil, nil, err
+	}
+	return mongo.usersWithLocs(session, ids, found, pref)
+}
+
+func (mongo *mongoDB) usersWithLocs(session *mgo.Session, ids []bson.ObjectId, found []geoLocation, pref string) (users []geoUser, locs map[bson.ObjectId]geoLocation, err error) {
+	err = session.DB(mongo.Database).C("dviUsers").Find(visible(bson.M{
+		"_id": bson.M{"$in": ids}, pref: true,
+	})).All(&users)
+	locs = map[bson.ObjectId]geoLocation{}
+	for _, l := range found {
+		locs[l.ID] = l
+	}
+	return users, locs, err
+}
+
+// postNotifications adds notes to the outbox, the ones whose key is in
+// it already are left out
+func (mongo *mongoDB) postNotifications(ctx context.Context, notes []notification) (err error) {
+	_, end := traceDB(ctx, "postNotifications")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	bulk := session.DB(mongo.Database).C("dviNotifications").Bulk()
+	bulk.Unordered()
+	for i := range notes {
+		bulk.Insert(&notes[i])
+	}
+	_, err = bulk.Run()
+	dup := map[int]bool{}
+	if berr, ok := err.(*mgo.BulkError); ok {
+		for _, cs := range berr.Cases() {
+			if !mgo.IsDup(cs.Err) {
+				return cs.Err
+			}
+			dup[cs.Index] = true
+		}
+		err = nil
+	}
+	for i, n := range notes {
+		if !dup[i] {
+			metrics.notifications.WithLabelValues(n.Channel, "queued").Inc()
+		}
+	}
+	return err
+}
+
+// claimNotification takes the next due note for a Lease. A note whose
+// sender died is due again when the lease is over.
+func (mongo *mongoDB) claimNotification(ctx context.Context, now time.Time, lease time.Duration) (note notification, err error) {
+	_, end := traceDB(ctx, "claimNotification")
+	defer func() {
+		if err == mgo.ErrNotFound {
+			end(nil)
+			return
+		}
+		end(&err)
+	}()
+	session := mongo.clone()
+	defer session.Close()
+
+	_, err = session.DB(mongo.Database).C("dviNotifications").Find(bson.M{
+		"status":  bson.M{"$in": []string{"pending", "sending"}},
+		"next_at": bson.M{"$lte": now},
+	}).Sort("next_at").Apply(mgo.Change{
+		Update:    bson.M{"$set": bson.M{"status": "sending", "next_at": now.Add(lease)}},
+		ReturnNew: true,
+	}, &note)
+	return note, err
+}
+
+func (mongo *mongoDB) updateNotification(ctx context.Context, id bson.ObjectId, set bson.M) (err error) {
+	_, end := traceDB(ctx, "updateNotification")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	return session.DB(mongo.Database).C("dviNotifications").UpdateId(id, bson.M{"$set": set})
+}
+
+// getNotifications is the outbox of user, newest first
+func (mongo *mongoDB) getNotifications(ctx context.Context, user bson.ObjectId, q *reqNotifications) (notes []notification, err error) {
+	_, end := traceDB(ctx, "getNotifications")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	query := bson.M{"user": user}
+	if q.Status != "" {
+		query["status"] = q.Status
+	}
+	notes = []notification{}
+	err = session.DB(mongo.Database).C("dviNotifications").Find(query).
+		Sort("-created_at").Limit(q.Limit).All(&notes)
+	return notes, err
+}
+
+// ========== webhooks
+
+func (mongo *mongoDB) postWebhook(ctx context.Context, hook *webhook) (err error) {
+	_, end := traceDB(ctx, "postWebhook")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	return session.DB(mongo.Database).C("dviWebhooks").Insert(hook)
+}
+
+func (mongo *mongoDB) getWebhooks(ctx context.Context) (hooks []webhook, err error) {
+	_, end := traceDB(ctx, "getWebhooks")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	hooks = []webhook{}
+	err = session.DB(mongo.Database).C("dviWebhooks").Find(bson.M{}).Sort("created_at").All(&hooks)
+	return hooks, err
+}
+
+func (mongo *mongoDB) getWebhook(ctx context.Context, id bson.ObjectId) (hook webhook, err error) {
+	_, end := traceDB(ctx, "getWebhook")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviWebhooks").FindId(id).One(&hook)
+	return hook, err
+}
+
+// getWebhooksFor is the webhooks subscribed to typ, a write to kind
+func (mongo *mongoDB) getWebhooksFor(ctx context.Context, kind, typ string) (hooks []webhook, err error) {
+	_, end := traceDB(ctx, "getWebhooksFor")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviWebhooks").Find(bson.M{
+		"events": bson.M{"$in": []string{typ, kind + ".*", "*"}},
+	}).All(&hooks)
+	return hooks, err
+}
+
+func (mongo *mongoDB) rotateWebhook(ctx context.Context, id bson.ObjectId, secret string, at time.Time) (hook webhook, err error) {
+	_, end := traceDB(ctx, "rotateWebhook")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	_, err = session.DB(mongo.Database).C("dviWebhooks").FindId(id).Apply(mgo.Change{
+		Update:    bson.M{"$set": bson.M{"secret": secret, "rotated_at": at}},
+		ReturnNew: true,
+	}, &hook)
+	return hook, err
+}
+
+// delWebhook removes a webhook and its deliveries, dead ones included
+func (mongo *mongoDB) delWebhook(ctx context.Context, id bson.ObjectId) (hook webhook, err error) {
+	_, end := traceDB(ctx, "delWebhook")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	_, err = session.DB(mongo.Database).C("dviWebhooks").FindId(id).Apply(mgo.Change{Remove: true}, &hook)
+	if err != nil {
+		return hook, err
+	}
+	_, err = session.DB(mongo.Database).C("dviDeliveries").RemoveAll(bson.M{"hook": id})
+	return hook, err
+}
+
+// locOwners is the collection of what a location locates
+var locOwners = map[string]string{"User": "dviUsers", "Event": "dviEvents"}
+
+// hookSubject is where the entity of c is and its tags. The document of
+// c has one of them, the other is read, a deleted entity may have neither.
+func (mongo *mongoDB) hookSubject(ctx context.Context, c change) (s hookSubject, err error) {
+	_, end := traceDB(ctx, "hookSubject")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+	db := session.DB(mongo.Database)
+
+	var loc geoLocation
+	var tagged struct {
+		Tags []string `bson:"tags"`
+	}
+	if c.Kind == "location" {
+		err = c.decode(&loc)
+		if coll := locOwners[loc.TObject]; err == nil && coll != "" {
+			err = db.C(coll).FindId(c.Entity).Select(bson.M{"tags": 1}).One(&tagged)
+		}
+	} else {
+		err = c.decode(&tagged)
+		if err == nil {
+			err = db.C("dviLocations").FindId(c.Entity).One(&loc)
+		}
+	}
+	if err != nil && err != mgo.ErrNotFound {
+		return s, err
+	}
+	if loc.Location.Type != "" {
+		s.Loc = &loc.Location
+	}
+	s.Tags = tagged.Tags
+	return s, nil
+}
+
+// postDeliveries queues dels, an event already queued for a webhook is
+// left out
+func (mongo *mongoDB) postDeliveries(ctx context.Context, dels []delivery) (err error) {
+	_, end := traceDB(ctx, "postDeliveries")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	bulk := session.DB(mongo.Database).C("dviDeliveries").Bulk()
+	bulk.Unordered()
+	for i := range dels {
+		bulk.Insert(&dels[i])
+	}
+	_, err = bulk.Run()
+	dup := 0
+	if berr, ok := err.(*mgo.BulkError); ok {
+		for _, cs := range berr.Cases() {
+			if !mgo.IsDup(cs.Err) {
+				return cs.Err
+			}
+			dup++
+		}
+		err = nil
+	}
+	metrics.webhooks.WithLabelValues("queued").Add(float64(len(dels) - dup))
+	return err
+}
+
+// claimDelivery takes the next due delivery for a lease, like
+// claimNotification
+func (mongo *mongoDB) claimDelivery(ctx context.Context, now time.Time, lease time.Duration) (dl delivery, err error) {
+	_, end := traceDB(ctx, "claimDelivery")
+	defer func() {
+		if err == mgo.ErrNotFound {
+			end(nil)
+			return
+		}
+		end(&err)
+	}()
+	session := mongo.clone()
+	defer session.Close()
+
+	_, err = session.DB(mongo.Database).C("dviDeliveries").Find(bson.M{
+		"status":  bson.M{"$in": []string{"pending", "sending"}},
+		"next_at": bson.M{"$lte": now},
+	}).Sort("next_at").Apply(mgo.Change{
+		Update:    bson.M{"$set": bson.M{"status": "sending", "next_at": now.Add(lease)}},
+		ReturnNew: true,
+	}, &dl)
+	return dl, err
+}
+
+func (mongo *mongoDB) updateDelivery(ctx context.Context, id bson.ObjectId, set bson.M) (err error) {
+	_, end := traceDB(ctx, "updateDelivery")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	return session.DB(mongo.Database).C("dviDeliveries").UpdateId(id, bson.M{"$set": set})
+}
+
+// getDeliveries is the deliveries of hook, newest first
+func (mongo *mongoDB) getDeliveries(ctx context.Context, hook bson.ObjectId, q *reqDeliveries) (dels []delivery, err error) {
+	_, end := traceDB(ctx, "getDeliveries")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	query := bson.M{"hook": hook}
+	if q.Status != "" {
+		query["status"] = q.Status
+	}
+	dels = []delivery{}
+	err = session.DB(mongo.Database).C("dviDeliveries").Find(query).
+		Sort("-created_at").Limit(q.Limit).All(&dels)
+	return dels, err
+}
+
+// replayDeliveries makes the dead deliveries of hook, or the delivery one
+// unless it is being sent, due at now with all their attempts again
+func (mongo *mongoDB) replayDeliveries(ctx context.Context, hook, one bson.ObjectId, now time.Time) (n int, err error) {
+	_, end := traceDB(ctx, "replayDeliveries")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	query := bson.M{"hook": hook, "status": "dead"}
+	if one != "" {
+		query = bson.M{"hook": hook, "_id": one, "status": bson.M{"$ne": "sending"}}
+	}
+	info, err := session.DB(mongo.Database).C("dviDeliveries").UpdateAll(query, bson.M{
+		"$set":   bson.M{"status": "pending", "attempts": 0, "next_at": now},
+		"$unset": bson.M{"last_status": "", "last_error": "", "delivered_at": ""},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if one != "" && info.Updated == 0 {
+		return 0, mgo.ErrNotFound
+	}
+	return info.Updated, nil
+}
+
+// ========== api keys
+
+func (mongo *mongoDB) getKeys(ctx context.Context) (keys []apiKey, err error) {
+	_, end := traceDB(ctx, "getKeys")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviKeys").Find(bson.M{}).Sort("created_at").All(&keys)
+	return keys, err
+}
+
+func (mongo *mongoDB) getKeyByPrefix(ctx context.Context, prefix string) (key apiKey, err error) {
+	_, end := traceDB(ctx, "getKeyByPrefix")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviKeys").Find(bson.M{"prefix": prefix}).One(&key)
+	return key, err
+}
+
+func (mongo *mongoDB) postKey(ctx context.Context, key *apiKey) (err error) {
+	_, end := traceDB(ctx, "postKey")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviKeys").Insert(key)
+	return err
+}
+
+// rotateKey swaps the key material of a key that isn't revoked
+func (mongo *mongoDB) rotateKey(ctx context.Context, id bson.ObjectId, prefix, hash string, at time.Time) (key apiKey, err error) {
+	_, end := traceDB(ctx, "rotateKey")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	_, err = session.DB(mongo.Database).C("dviKeys").Find(bson.M{
+		"_id":        id,
+		"revoked_at": bson.M{"$exists": false},
+	}).Apply(mgo.Change{
+		Update:    bson.M{"$set": bson.M{"prefix": prefix, "hash": hash, "rotated_at": at}},
+		ReturnNew: true,
+	}, &key)
+	return key, err
+}
+
+// revokeKey keeps the key for its history but it never authenticates again
+func (mongo *mongoDB) revokeKey(ctx context.Context, id bson.ObjectId, at time.Time) (key apiKey, err error) {
+	_, end := traceDB(ctx, "revokeKey")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	_, err = session.DB(mongo.Database).C("dviKeys").Find(bson.M{
+		"_id":        id,
+		"revoked_at": bson.M{"$exists": false},
+	}).Apply(mgo.Change{
+		Update:    bson.M{"$set": bson.M{"revoked_at": at}},
+		ReturnNew: true,
+	}, &key)
+	return key, err
+}
+
+func (mongo *mongoDB) touchKey(ctx context.Context, id bson.ObjectId, at time.Time, ip string) (err error) {
+	_, end := traceDB(ctx, "touchKey")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviKeys").UpdateId(id, bson.M{
+		"$set": bson.M{"last_used_at": at, "last_used_ip": ip},
+	})
+	return err
+}
+
 // ========== user
 
-func (mongo *mongoDB) getUsers() (users []geoUser, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) getUsers(ctx context.Context) (users []geoUser, err error) {
+	_, end := traceDB(ctx, "getUsers")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	err = session.DB(mongo.Database).C("dviUsers").Find(bson.M{}).All(&users)
+	err = session.DB(mongo.Database).C("dviUsers").Find(visible(bson.M{})).All(&users)
 	return users, err
 }
 
-func (mongo *mongoDB) getUser(u *geoUser) (gu geoUser, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) getUser(ctx context.Context, u *geoUser) (gu geoUser, err error) {
+	_, end := traceDB(ctx, "getUser")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	if u.Email != "" {
-		err = session.DB(mongo.Database).C("dviUsers").Find(bson.M{
+		err = session.DB(mongo.Database).C("dviUsers").Find(visibleTo(ctx, bson.M{
 			"email": u.Email,
-		}).One(&gu)
+		})).One(&gu)
 	} else if u.ID.Hex() != "" {
-		err = session.DB(mongo.Database).C("dviEvents").Find(bson.M{
+		err = session.DB(mongo.Database).C("dviUsers").Find(visibleTo(ctx, bson.M{
 			"_id": u.ID,
-		}).One(&gu)
+		})).One(&gu)
 	}
 	return gu, err
 }
 
-func (mongo *mongoDB) postUser(user *geoUser) (err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) postUser(ctx context.Context, user *geoUser) (err error) {
+	_, end := traceDB(ctx, "postUser")
+	defer end(&err)
+	session := mongo.clone()
 
 	defer session.Close()
 	user.ID = bson.NewObjectId()
+	user.Version = 1
+	user.Role = ""
+	user.Moderation = nil
 
 	err = session.DB(mongo.Database).C("dviUsers").Insert(&user)
+	if err == nil {
+		mongo.publish(ctx, newChange(ctx, "user", "create", user.ID, nil, user))
+	}
 	return err
 }
 
-func (mongo *mongoDB) updateUser(u *geoUser) (err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) updateUser(ctx context.Context, u *geoUser) (err error) {
+	_, end := traceDB(ctx, "updateUser")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	err = session.DB(mongo.Database).C("dviUsers").
-		Update(bson.M{"_id": u.ID}, &u)
+	c := session.DB(mongo.Database).C("dviUsers")
+	// privacy and notify are the user's, others may only keep the stored ones
+	if !viewerFrom(ctx).owns(u.ID) {
+		var stored geoUser
+		err = c.FindId(u.ID).Select(bson.M{"privacy": 1, "notify": 1}).One(&stored)
+		if err != nil {
+			return err
+		}
+		if (u.Privacy != nil && !reflect.DeepEqual(u.Privacy, stored.Privacy)) ||
+			(u.Notify != nil && !reflect.DeepEqual(u.Notify, stored.Notify)) {
+			return errNotOwner
+		}
+		u.Privacy, u.Notify = stored.Privacy, stored.Notify
+	}
+
+	err = mongo.replaceAudited(ctx, c, u.ID, &u.Version, u)
 	return err
 }
 
-func (mongo *mongoDB) delUser(u *geoUser) (err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) delUser(ctx context.Context, u *geoUser) (err error) {
+	_, end := traceDB(ctx, "delUser")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	if u.ID.Hex() != "" {
-		err = session.DB(mongo.Database).C("dviUsers").RemoveId(u.ID)
+		err = mongo.removeAudited(ctx, session.DB(mongo.Database).C("dviUsers"), u.ID)
+		if err != nil {
+			return err
+		}
+		_, err = session.DB(mongo.Database).C("dviRelations").RemoveAll(bson.M{
+			"$or": []bson.M{{"from": u.ID}, {"to": u.ID}},
+		})
 		return err
 	}
 	return err
@@ -179,77 +1376,143 @@ func (mongo *mongoDB) delUser(u *geoUser) (err error) {
 
 // ========== event
 
-func (mongo *mongoDB) getEvents() (events []geoEvent, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) getEvents(ctx context.Context) (events []geoEvent, err error) {
+	_, end := traceDB(ctx, "getEvents")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	err = session.DB(mongo.Database).C("dviEvents").Find(bson.M{}).All(&events)
+	err = session.DB(mongo.Database).C("dviEvents").Find(visible(bson.M{})).All(&events)
 	return events, err
 }
 
-func (mongo *mongoDB) getEvent(event *geoEvent) (gevent geoEvent, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) getEvent(ctx context.Context, event *geoEvent) (gevent geoEvent, err error) {
+	_, end := traceDB(ctx, "getEvent")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	if event.ID.Hex() != "" {
-		err = session.DB(mongo.Database).C("dviEvents").Find(bson.M{
+		err = session.DB(mongo.Database).C("dviEvents").Find(visibleTo(ctx, bson.M{
 			"_id": event.ID,
-		}).One(&gevent)
+		})).One(&gevent)
 	}
 	return gevent, err
 }
 
-func (mongo *mongoDB) postEvents(events *[]geoEvent) (err error) {
-	session := mongo.Session.Clone()
+// postEvents writes events in one unordered bulk, inserting them or, with
+// upsert, updating the ones with the same external_id. errs[i] is nil when
+// events[i] was written and its ID is set.
+func (mongo *mongoDB) postEvents(ctx context.Context, events []geoEvent, upsert bool) (errs []error, err error) {
+	_, end := traceDB(ctx, "postEvents")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	for _, event := range *events {
-		event.ID = bson.NewObjectId()
-		err = session.DB(mongo.Database).C("dviEvents").Insert(&event)
+	docs := make([]bulkDoc, len(events))
+	for i := range events {
+		if !upsert {
+			events[i].ID = bson.NewObjectId()
+			events[i].Version = 1
+		}
+		events[i].Moderation = nil
+		docs[i] = bulkDoc{ID: events[i].ID, ExternalID: events[i].ExternalID, Doc: &events[i]}
 	}
-	return err
+	err = bulkWrite(session.DB(mongo.Database).C("dviEvents"), docs, upsert)
+	mongo.publishBulk(ctx, "event", docs, upsert)
+	errs = make([]error, len(events))
+	for i := range docs {
+		events[i].ID = docs[i].ID
+		errs[i] = docs[i].Err
+	}
+	return errs, err
 }
 
-func (mongo *mongoDB) postEvent(event *geoEvent) (err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) postEvent(ctx context.Context, event *geoEvent) (err error) {
+	_, end := traceDB(ctx, "postEvent")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	event.ID = bson.NewObjectId()
+	event.Version = 1
+	event.Moderation = nil
 	err = session.DB(mongo.Database).C("dviEvents").Insert(&event)
+	if err == nil {
+		mongo.publish(ctx, newChange(ctx, "event", "create", event.ID, nil, event))
+	}
 	return err
 }
 
-func (mongo *mongoDB) updateEvent(event *geoEvent) (err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) updateEvent(ctx context.Context, event *geoEvent) (err error) {
+	_, end := traceDB(ctx, "updateEvent")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	err = session.DB(mongo.Database).C("dviEvents").Update(
-		bson.M{"_id": event.ID}, &event)
+	err = mongo.replaceAudited(ctx, session.DB(mongo.Database).C("dviEvents"),
+		event.ID, &event.Version, event)
 	return err
 }
 
-func (mongo *mongoDB) delEvent(event *geoEvent) (err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) delEvent(ctx context.Context, event *geoEvent) (err error) {
+	_, end := traceDB(ctx, "delEvent")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	if event.ID.Hex() != "" {
-		err = session.DB(mongo.Database).C("dviEvents").RemoveId(event.ID)
+		err = mongo.removeAudited(ctx, session.DB(mongo.Database).C("dviEvents"), event.ID)
 	}
 	return err
 }
 
 // ========== point
 
-func (mongo *mongoDB) getLocs() (locs []geoLocation, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) getLocs(ctx context.Context) (locs []geoLocation, err error) {
+	_, end := traceDB(ctx, "getLocs")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	err = session.DB(mongo.Database).C("dviLocations").Find(bson.M{}).All(&locs)
-	return locs, err
+	err = session.DB(mongo.Database).C("dviLocations").Find(visible(bson.M{})).All(&locs)
+	if err != nil {
+		return locs, err
+	}
+	return mongo.shieldLocs(ctx, locs, nil)
+}
+
+// getLoc is point as the viewer of ctx may see it
+func (mongo *mongoDB) getLoc(ctx context.Context, point *geoLocation) (gpoint geoLocation, err error) {
+	gpoint, err = mongo.readLoc(ctx, point)
+	if err != nil || point.ID.Hex() == "" {
+		return gpoint, err
+	}
+	return mongo.viewLoc(ctx, gpoint)
+}
+
+// viewLoc is gpoint as the viewer of ctx sees it, moderated or hidden
+// locations are not found but for staff
+func (mongo *mongoDB) viewLoc(ctx context.Context, gpoint geoLocation) (geoLocation, error) {
+	if gpoint.Moderation != nil && !viewerFrom(ctx).staff() &&
+		(gpoint.Moderation.Hidden || gpoint.Moderation.Suspended) {
+		return geoLocation{}, mgo.ErrNotFound
+	}
+	locs, err := mongo.shieldLocs(ctx, []geoLocation{gpoint}, nil)
+	if err == nil && len(locs) == 0 {
+		err = mgo.ErrNotFound
+	}
+	if err != nil {
+		return geoLocation{}, err
+	}
+	return locs[0], nil
 }
 
-func (mongo *mongoDB) getLoc(point *geoLocation) (gpoint geoLocation, err error) {
-	session := mongo.Session.Clone()
+// readLoc is point as stored, for the writes that start from it
+func (mongo *mongoDB) readLoc(ctx context.Context, point *geoLocation) (gpoint geoLocation, err error) {
+	_, end := traceDB(ctx, "readLoc")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	if point.ID.Hex() != "" {
@@ -261,82 +1524,392 @@ func (mongo *mongoDB) getLoc(point *geoLocation) (gpoint geoLocation, err error)
 	return gpoint, err
 }
 
-func (mongo *mongoDB) postLoc(point *geoLocation) (gpoint *geoLocation, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) postLoc(ctx context.Context, point *geoLocation) (gpoint *geoLocation, err error) {
+	_, end := traceDB(ctx, "postLoc")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	point.ID = bson.NewObjectId()
+	point.Version = 1
+	point.Moderation = nil
 	err = session.DB(mongo.Database).C("dviLocations").Insert(&point)
+	if err == nil {
+		mongo.publish(ctx, newChange(ctx, "location", "create", point.ID, nil, point))
+	}
 	return point, err
 }
 
-func (mongo *mongoDB) postLocs(locs *[]geoLocation) (err error) {
-	session := mongo.Session.Clone()
+// writeFixes moves each device location to its fix in one unordered bulk.
+// A fix older than the stored one misses the filter, its upsert then hits the
+// unique external_id and it is counted as stale instead of failing. The
+// locations that moved are published as moves.
+func (mongo *mongoDB) writeFixes(ctx context.Context, fixes []fix) (stale int, err error) {
+	_, end := traceDB(ctx, "writeFixes")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	for _, point := range *locs {
-		point.ID = bson.NewObjectId()
-		err = session.DB(mongo.Database).C("dviLocations").Insert(&point)
+	// a device moved if its location has the newest of its fixes
+	newest := map[string]time.Time{}
+	devices := []string{}
+	for _, f := range fixes {
+		ts := time.Unix(0, f.TS*int64(time.Millisecond))
+		if _, ok := newest[f.Device]; !ok {
+			devices = append(devices, f.Device)
+		}
+		if ts.After(newest[f.Device]) {
+			newest[f.Device] = ts
+		}
 	}
-	return err
+	c := session.DB(mongo.Database).C("dviLocations")
+	// where the devices were, for the audit log
+	before, err := byExternalID(c, devices)
+	if err != nil {
+		return 0, err
+	}
+
+	bulk := c.Bulk()
+	bulk.Unordered()
+	for _, f := range fixes {
+		ts := time.Unix(0, f.TS*int64(time.Millisecond))
+		bulk.Upsert(
+			bson.M{"external_id": f.Device, "$or": []bson.M{
+				{"fix_ts": bson.M{"$lt": ts}},
+				{"fix_ts": bson.M{"$exists": false}},
+			}},
+			bson.M{
+				"$set": bson.M{
+					"location": geoObject{Type: "Point", Coordinates: [2]float64{f.Lng, f.Lat}},
+					"fix_ts":   ts,
+					"accuracy": f.Accuracy,
+					"speed":    f.Speed,
+				},
+				"$setOnInsert": bson.M{"_id": bson.NewObjectId(), "tobject": "User"},
+				"$inc":         bson.M{"version": 1},
+			},
+		)
+	}
+	_, err = bulk.Run()
+	if berr, ok := err.(*mgo.BulkError); ok {
+		for _, cs := range berr.Cases() {
+			if !mgo.IsDup(cs.Err) {
+				return stale, cs.Err
+			}
+			stale++
+		}
+		err = nil
+	}
+	if err != nil || len(fixes) == stale {
+		return stale, err
+	}
+
+	after, err := byExternalID(c, devices)
+	changes := []change{}
+	for _, device := range devices {
+		loc := after[device]
+		id, _ := loc["_id"].(bson.ObjectId)
+		ts, _ := loc["fix_ts"].(time.Time)
+		was, _ := before[device]["fix_ts"].(time.Time)
+		if ts.Equal(newest[device]) && !was.Equal(ts) {
+			changes = append(changes, newChange(ctx, "location", "move", id, before[device], loc))
+		}
+	}
+	mongo.publish(ctx, changes...)
+	return stale, err
 }
 
-func (mongo *mongoDB) updateLoc(point *geoLocation) (err error) {
-	session := mongo.Session.Clone()
+// postLocs is postEvents for locations
+func (mongo *mongoDB) postLocs(ctx context.Context, locs []geoLocation, upsert bool) (errs []error, err error) {
+	_, end := traceDB(ctx, "postLocs")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	err = session.DB(mongo.Database).C("dviLocations").Update(
-		bson.M{"_id": point.ID}, &point)
-	return err
+	docs := make([]bulkDoc, len(locs))
+	for i := range locs {
+		if !upsert {
+			locs[i].ID = bson.NewObjectId()
+			locs[i].Version = 1
+		}
+		locs[i].Moderation = nil
+		docs[i] = bulkDoc{ID: locs[i].ID, ExternalID: locs[i].ExternalID, Doc: &locs[i]}
+	}
+	err = bulkWrite(session.DB(mongo.Database).C("dviLocations"), docs, upsert)
+	mongo.publishBulk(ctx, "location", docs, upsert)
+	errs = make([]error, len(locs))
+	for i := range docs {
+		locs[i].ID = docs[i].ID
+		errs[i] = docs[i].Err
+	}
+	return errs, err
+}
+
+func (mongo *mongoDB) updateLoc(ctx context.Context, point *geoLocation) (err error) {
+	_, end := traceDB(ctx, "updateLoc")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	return mongo.replaceAudited(ctx, session.DB(mongo.Database).C("dviLocations"),
+		point.ID, &point.Version, point)
 }
 
-func (mongo *mongoDB) delLoc(point *geoLocation) (err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) delLoc(ctx context.Context, point *geoLocation) (err error) {
+	_, end := traceDB(ctx, "delLoc")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	if point.ID.Hex() != "" {
-		fmt.Println(point.ID)
-		err = session.DB(mongo.Database).C("dviLocations").RemoveId(point.ID)
+		slog.DebugContext(ctx, "del loc", "id", point.ID.Hex())
+		err = mongo.removeAudited(ctx, session.DB(mongo.Database).C("dviLocations"), point.ID)
 	}
 	return err
 }
 
-func (mongo *mongoDB) getNearLoc(near *reqNear) (locs []geoLocation, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) getNearLoc(ctx context.Context, near *reqNear) (locs []geoLocation, err error) {
+	_, end := traceDB(ctx, "getNearLoc")
+	defer end(&err)
+	return mongo.nearLocs(ctx, near, bson.M{})
+}
+
+// getFriendsNear is getNearLoc for the locations of the friends of user
+func (mongo *mongoDB) getFriendsNear(ctx context.Context, user bson.ObjectId, near *reqNear) (locs []geoLocation, err error) {
+	_, end := traceDB(ctx, "getFriendsNear")
+	defer end(&err)
+	friends, err := mongo.friendIDs(ctx, user, nil)
+	if err != nil || len(friends) == 0 {
+		return []geoLocation{}, err
+	}
+	ids := make([]bson.ObjectId, 0, len(friends))
+	for id := range friends {
+		ids = append(ids, id)
+	}
+	return mongo.nearLocs(ctx, near, bson.M{"_id": bson.M{"$in": ids}, "tobject": "User"})
+}
+
+// nearLocs is the visible locations matching query within the scope of
+// near, nearest first
+func (mongo *mongoDB) nearLocs(ctx context.Context, near *reqNear, query bson.M) (locs []geoLocation, err error) {
+	session := mongo.clone()
+	defer session.Close()
+
+	query["location"] = bson.M{
+		"$nearSphere": bson.M{
+			"$geometry": bson.M{
+				"type":        near.TGeos,
+				"coordinates": []float64{near.Lng, near.Lat},
+			},
+			"$maxDistance": near.Scope + mongo.Privacy.margin(),
+		},
+	}
+	err = session.DB(mongo.Database).C("dviLocations").Find(visible(query)).All(&locs)
+	if err != nil {
+		return locs, err
+	}
+	return mongo.shieldLocs(ctx, locs, func(loc geoObject, fuzzed bool) bool {
+		return sphereDistance(near.Lng, near.Lat, loc.Coordinates[0], loc.Coordinates[1]) <= near.Scope
+	})
+}
+
+// getLocsInBox finds locations inside [minLng, minLat, maxLng, maxLat]
+func (mongo *mongoDB) getLocsInBox(ctx context.Context, box *reqBBox) (locs []geoLocation, err error) {
+	_, end := traceDB(ctx, "getLocsInBox")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
-	err = session.DB(mongo.Database).C("dviLocations").Find(bson.M{
+	wide := box.widen(mongo.Privacy.margin())
+	ring := [][]float64{
+		{wide.MinLng, wide.MinLat},
+		{wide.MaxLng, wide.MinLat},
+		{wide.MaxLng, wide.MaxLat},
+		{wide.MinLng, wide.MaxLat},
+		{wide.MinLng, wide.MinLat},
+	}
+	err = session.DB(mongo.Database).C("dviLocations").Find(visible(bson.M{
 		"location": bson.M{
-			"$nearSphere": bson.M{
+			"$geoWithin": bson.M{
 				"$geometry": bson.M{
-					"type":        near.TGeos,
-					"coordinates": []float64{near.Lng, near.Lat},
+					"type":        "Polygon",
+					"coordinates": [][][]float64{ring},
 				},
-				"$maxDistance": near.Scope,
 			},
 		},
-	}).All(&locs)
+	})).Limit(box.Limit).All(&locs)
+	if err != nil {
+		return locs, err
+	}
+	return mongo.shieldLocs(ctx, locs, func(loc geoObject, fuzzed bool) bool {
+		return box.holds(loc)
+	})
+}
+
+// ========== privacy
+
+// shield applies the privacy of their users to the User locations among n
+// results, at gives the id, tobject and location of result i. Fuzzed
+// locations are moved in place and within, if set, keeps a result by where
+// it is served. It tells for each result if it is kept.
+func (mongo *mongoDB) shield(ctx context.Context, n int, at func(i int) (bson.ObjectId, string, *geoObject),
+	within func(loc geoObject, fuzzed bool) bool) (keep []bool, err error) {
+	ids := []bson.ObjectId{}
+	for i := 0; i < n; i++ {
+		if id, tobject, _ := at(i); tobject == "User" {
+			ids = append(ids, id)
+		}
+	}
+	settings := map[bson.ObjectId]*privacy{}
+	if len(ids) > 0 {
+		_, end := traceDB(ctx, "getPrivacy")
+		session := mongo.clone()
+		users := []geoUser{}
+		err = session.DB(mongo.Database).C("dviUsers").Find(bson.M{
+			"_id":     bson.M{"$in": ids},
+			"privacy": bson.M{"$exists": true},
+		}).Select(bson.M{"privacy": 1}).All(&users)
+		session.Close()
+		end(&err)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			settings[u.ID] = u.Privacy
+		}
+	}
+
+	v := viewerFrom(ctx)
+	friends := map[bson.ObjectId]bool{}
+	if v.User != "" {
+		among := []bson.ObjectId{}
+		for _, id := range ids {
+			if mongo.Privacy.settings(settings[id]).Visibility == "friends" {
+				among = append(among, id)
+			}
+		}
+		if len(among) > 0 {
+			friends, err = mongo.friendIDs(ctx, v.User, among)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	keep = make([]bool, n)
+	for i := range keep {
+		id, tobject, loc := at(i)
+		show, fuzzed := true, false
+		if tobject == "User" {
+			s := mongo.Privacy.settings(settings[id])
+			show, fuzzed = v.show(id, s, friends[id])
+			if fuzzed {
+				*loc = mongo.Privacy.fuzz(s.Precision, *loc)
+			}
+		}
+		keep[i] = show && (within == nil || within(*loc, fuzzed))
+	}
+	return keep, nil
+}
+
+func (mongo *mongoDB) shieldLocs(ctx context.Context, locs []geoLocation,
+	within func(loc geoObject, fuzzed bool) bool) ([]geoLocation, error) {
+	keep, err := mongo.shield(ctx, len(locs), func(i int) (bson.ObjectId, string, *geoObject) {
+		return locs[i].ID, locs[i].TObject, &locs[i].Location
+	}, within)
+	return kept(locs, keep), err
+}
+
+// kept is the items shield keeps, in order
+func kept[T any](items []T, keep []bool) []T {
+	if keep == nil {
+		return items
+	}
+	out := items[:0]
+	for i, item := range items {
+		if keep[i] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// ========== batches
+
+// the loaders of graphql.go read documents by id in one query per batch
 
-	return locs, err
+func (mongo *mongoDB) getUsersByID(ctx context.Context, ids []bson.ObjectId) (users []geoUser, err error) {
+	_, end := traceDB(ctx, "getUsersByID")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviUsers").Find(visibleTo(ctx, bson.M{
+		"_id": bson.M{"$in": ids},
+	})).All(&users)
+	return users, err
+}
+
+func (mongo *mongoDB) getEventsByID(ctx context.Context, ids []bson.ObjectId) (events []geoEvent, err error) {
+	_, end := traceDB(ctx, "getEventsByID")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviEvents").Find(visibleTo(ctx, bson.M{
+		"_id": bson.M{"$in": ids},
+	})).All(&events)
+	return events, err
+}
+
+func (mongo *mongoDB) getLocsByID(ctx context.Context, ids []bson.ObjectId) (locs []geoLocation, err error) {
+	_, end := traceDB(ctx, "getLocsByID")
+	defer end(&err)
+	session := mongo.clone()
+	defer session.Close()
+
+	err = session.DB(mongo.Database).C("dviLocations").Find(visibleTo(ctx, bson.M{
+		"_id": bson.M{"$in": ids},
+	})).All(&locs)
+	if err != nil {
+		return locs, err
+	}
+	return mongo.shieldLocs(ctx, locs, nil)
 }
 
 // ========== geoloc+event
 
-func (mongo *mongoDB) postGeoEvent(gv *reqGeoEvent) (res respondID, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) postGeoEvent(ctx context.Context, gv *reqGeoEvent) (res respondID, err error) {
+	_, end := traceDB(ctx, "postGeoEvent")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	res.ID = bson.NewObjectId()
 	gv.Event.ID = res.ID
 	gv.GeoLoc.ID = res.ID
+	gv.Event.Version = 1
+	gv.GeoLoc.Version = 1
+	gv.Event.Moderation = nil
+	gv.GeoLoc.Moderation = nil
 
 	err = session.DB(mongo.Database).C("dviLocations").Insert(&gv.GeoLoc)
+	if err != nil {
+		return res, err
+	}
+	mongo.publish(ctx, newChange(ctx, "location", "create", res.ID, nil, &gv.GeoLoc))
 	err = session.DB(mongo.Database).C("dviEvents").Insert(&gv.Event)
-	return res, err
+	if err != nil {
+		return res, err
+	}
+	mongo.publish(ctx, newChange(ctx, "event", "create", res.ID, nil, &gv.Event))
+	return res, nil
 }
 
-func (mongo *mongoDB) getFiltered(filter *reqFilter) (elocs []eventLoc, err error) {
-	session := mongo.Session.Clone()
+func (mongo *mongoDB) getFiltered(ctx context.Context, filter *reqFilter) (elocs []eventLoc, err error) {
+	ctx, end := traceDB(ctx, "getFiltered")
+	defer end(&err)
+	session := mongo.clone()
 	defer session.Close()
 
 	params := []bson.M{}
@@ -352,6 +1925,7 @@ func (mongo *mongoDB) getFiltered(filter *reqFilter) (elocs []eventLoc, err erro
 			"distanceField": "distance",
 			"includeLocs":   "location",
 			"maxDistance":   filter.Scope,
+			"query":         visible(bson.M{}),
 		},
 	})
 
@@ -378,8 +1952,11 @@ func (mongo *mongoDB) getFiltered(filter *reqFilter) (elocs []eventLoc, err erro
 				"preserveNullAndEmptyArrays": true,
 			},
 		})
-		if len(filter.Tags) > 0 && filter.Tags[0] != "" {
+		// REST sends tags=a,b, graphql and grpc send a list
+		if len(filter.Tags) == 1 {
 			filter.Tags = strings.Split(filter.Tags[0], ",")
+		}
+		if len(filter.Tags) > 0 && filter.Tags[0] != "" {
 			params = append(params, bson.M{
 				"$match": bson.M{
 					"Events.tags": bson.M{"$in": filter.Tags},
@@ -421,8 +1998,11 @@ func (mongo *mongoDB) getFiltered(filter *reqFilter) (elocs []eventLoc, err erro
 				"preserveNullAndEmptyArrays": true,
 			},
 		})
-		if len(filter.Tags) > 0 && filter.Tags[0] != "" {
+		// REST sends tags=a,b, graphql and grpc send a list
+		if len(filter.Tags) == 1 {
 			filter.Tags = strings.Split(filter.Tags[0], ",")
+		}
+		if len(filter.Tags) > 0 && filter.Tags[0] != "" {
 			params = append(params, bson.M{
 				"$match": bson.M{
 					"Users.tags": bson.M{"$in": filter.Tags},
@@ -442,8 +2022,19 @@ func (mongo *mongoDB) getFiltered(filter *reqFilter) (elocs []eventLoc, err erro
 		})
 	}
 
+	tracePipeline(ctx, params)
 	err = session.DB(mongo.Database).C("dviLocations").Pipe(params).All(&elocs)
-	return elocs, err
+	if err != nil {
+		return elocs, err
+	}
+	// maxDistance of a legacy near is in radians, so only the fuzzed
+	// locations are kept by the scope in meters
+	keep, err := mongo.shield(ctx, len(elocs), func(i int) (bson.ObjectId, string, *geoObject) {
+		return elocs[i].ID, elocs[i].TObject, &elocs[i].Location
+	}, func(loc geoObject, fuzzed bool) bool {
+		return !fuzzed || sphereDistance(filter.Lng, filter.Lat, loc.Coordinates[0], loc.Coordinates[1]) <= filter.Scope
+	})
+	return kept(elocs, keep), err
 }
 
 func wordToDate(ttime string) (dateStart time.Time, dateEnd time.Time) {
@@ -1,23 +1,39 @@
 package main
 
 import (
-	gen "github.com/asm-jaime/gen"
+	"bytes"
+	"context"
+	"encoding/json"
 	mgo "gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
+	"io"
 	"log"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+// randStr returns n random lowercase letters
+func randStr(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('a' + rand.Intn(26))
+	}
+	return string(b)
+}
+
 func userRnd() (u geoUser) {
 	u.ID = bson.NewObjectId()
-	u.Name = "jhon " + gen.Str(4)
-	u.Email = gen.Str(6) + "@" + gen.Str(4) + "." + gen.Str(2)
-	u.Text = "descr: " + gen.Str(10)
+	u.Name = "jhon " + randStr(4)
+	u.Email = randStr(6) + "@" + randStr(4) + "." + randStr(2)
+	u.Text = "descr: " + randStr(10)
 	u.Tags = []string{[]string{"whoredom", "debauch", "drugs"}[rand.Intn(3)]}
 	return u
 }
@@ -36,7 +52,7 @@ func pointRnd() (loc geoLocation) {
 func eventRnd() (e geoEvent) {
 	e.ID = bson.NewObjectId()
 	e.Name = "event: " + string(e.ID)
-	e.Text = "descr: " + gen.Str(10)
+	e.Text = "descr: " + randStr(10)
 	e.Tags = []string{[]string{"whoredom", "debauch", "drugs"}[rand.Intn(3)]}
 	e.Timestamp = time.Now().Add(-time.Duration(rand.Intn(100)) * time.Hour)
 	return e
@@ -83,16 +99,37 @@ func fillRndToDB(mongo *mongoDB, num int) (err error) {
 	return err
 }
 
+// patchLocReq sends patch to location id through the router, as an
+// anonymous viewer
+func patchLocReq(db *mongoDB, id bson.ObjectId, patch string) (int, geoLocation) {
+	req, _ := http.NewRequest("PATCH", "/api/v1/locs/"+id.Hex(), strings.NewReader(patch))
+	req.Header.Set("Content-Type", mergePatchContentType)
+	response := httptest.NewRecorder()
+	newTestRouter(defaultConfig(), db).ServeHTTP(response, req)
+	res := struct {
+		Body geoLocation `json:"body"`
+	}{}
+	json.Unmarshal(response.Body.Bytes(), &res)
+	return response.Code, res.Body
+}
+
 func dbTest() (mongo *mongoDB, err error) {
 	os.Setenv("MONGO_NAME", "test")
 	os.Setenv("MONGO_USER", "jaime")
 	os.Setenv("MONGO_PASSWORD", "123456789")
 	os.Setenv("MONGO_HOST", "localhost")
 	os.Setenv("MONGO_PORT", "27017")
+	conf, err := loadConfig("")
+	if err != nil {
+		return nil, err
+	}
 	mongo = &mongoDB{}
-	mongo.setDefault()
+	err = mongo.setConfig(&conf.Mongo)
+	if err != nil {
+		return nil, err
+	}
 
-	err = mongo.init()
+	err = mongo.reset(io.Discard)
 	return mongo, err
 }
 
@@ -105,14 +142,14 @@ func TestMongoDB(t *testing.T) {
 
 	// locations
 	{
-		locs, err := db.getLocs()
+		locs, err := db.getLocs(context.Background())
 		if err != nil || len(locs) == 0 {
 			t.Error("error getLocs: ", err)
 		}
 	}
 	// events
 	{
-		events, err := db.getEvents()
+		events, err := db.getEvents(context.Background())
 		if err != nil || len(events) == 0 {
 			t.Error("error getEvents: ", err)
 		}
@@ -128,22 +165,52 @@ func TestLocation(t *testing.T) {
 	// case post/update/get
 	{
 		point := pointRnd()
-		_, err = db.postLoc(&point)
+		_, err = db.postLoc(context.Background(), &point)
 		if err != nil {
 			t.Error("err postLoc: ", err)
 		}
 		pointSec := pointRnd()
 		pointSec.ID = point.ID
-		err = db.updateLoc(&pointSec)
+		err = db.updateLoc(context.Background(), &pointSec)
 		if err != nil {
 			t.Error("err updateLoc: ", err)
 		}
-		pointCheck, err := db.getLoc(&pointSec)
+		pointCheck, err := db.getLoc(context.Background(), &pointSec)
 		if err != nil {
 			t.Error("err getLoc: ", err)
 		}
 		assert.NotEqual(t, point, pointCheck, "point should be updated")
 	}
+
+	// case versions
+	{
+		point := pointRnd()
+		_, err = db.postLoc(context.Background(), &point)
+		if err != nil {
+			t.Error("err postLoc: ", err)
+		}
+		assert.Equal(t, int64(1), point.Version, "new point has version 1")
+
+		stale := point
+		err = db.updateLoc(context.Background(), &point)
+		if err != nil {
+			t.Error("err updateLoc: ", err)
+		}
+		assert.Equal(t, int64(2), point.Version, "update bumps the version")
+
+		err = db.updateLoc(context.Background(), &stale)
+		assert.Equal(t, errVersionMismatch, err, "stale version should not update")
+	}
+
+	// case the deprecated put doesn't create an unknown id
+	{
+		body, _ := json.Marshal(pointRnd())
+		req, _ := http.NewRequest("PUT", "/api/v1/locs", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		response := httptest.NewRecorder()
+		newTestRouter(defaultConfig(), db).ServeHTTP(response, req)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	}
 }
 
 func TestNearLoc(t *testing.T) {
@@ -158,7 +225,7 @@ func TestNearLoc(t *testing.T) {
 		req.TGeos = "Point"
 		req.Lat = (rand.Float64() * 180) - 90
 		req.Lng = (rand.Float64() * 360) - 180
-		locs, err := db.getNearLoc(&req)
+		locs, err := db.getNearLoc(context.Background(), &req)
 		if err != nil {
 			t.Error("err getNearLoc: ", err)
 		}
@@ -180,13 +247,13 @@ func TestGeoEvent(t *testing.T) {
 		loc := pointRnd()
 		event := eventRnd()
 		gv := reqGeoEvent{Event: event, GeoLoc: loc}
-		id, err := db.postGeoEvent(&gv)
+		id, err := db.postGeoEvent(context.Background(), &gv)
 		if err != nil {
 			t.Error("err postGeoEvent: ", err)
 		}
 
 		loc.ID = id.ID
-		gloc, err := db.getLoc(&loc)
+		gloc, err := db.getLoc(context.Background(), &loc)
 		if err != nil {
 			t.Error("err get: ", err)
 		}
@@ -210,7 +277,7 @@ func TestFilterEventLoc(t *testing.T) {
 		req.Lat = 11
 		req.Lng = 8
 		log.Println(req)
-		elocs, err := db.getFiltered(&req)
+		elocs, err := db.getFiltered(context.Background(), &req)
 		if err != nil || len(elocs) == 0 {
 			t.Error("err getFiltered: ", err)
 			return
@@ -219,3 +286,616 @@ func TestFilterEventLoc(t *testing.T) {
 		assert.Equal(t, time.Now().Day(), elocs[0].Timestamp.Day(), "day does not match")
 	}
 }
+
+func TestModeration(t *testing.T) {
+	db, err := dbTest()
+	if err != nil {
+		t.Error("db err: ", err)
+	}
+	ctx := context.Background()
+
+	loc := pointRnd()
+	gv := reqGeoEvent{Event: eventRnd(), GeoLoc: loc}
+	res, err := db.postGeoEvent(ctx, &gv)
+	if err != nil {
+		t.Error("err postGeoEvent: ", err)
+	}
+	listed := func() (events, locs int) {
+		evs, _ := db.getEvents(ctx)
+		ls, _ := db.getLocs(ctx)
+		for _, e := range evs {
+			if e.ID == res.ID {
+				events++
+			}
+		}
+		for _, l := range ls {
+			if l.ID == res.ID {
+				locs++
+			}
+		}
+		return events, locs
+	}
+
+	// case hide, the location of the event goes with it
+	{
+		mod := &moderation{Hidden: true, Reason: "spam", By: "test", At: time.Now()}
+		_, err = db.moderate(ctx, modEvent, res.ID, mod, "hide")
+		if err != nil {
+			t.Error("err moderate: ", err)
+		}
+		events, locs := listed()
+		assert.Equal(t, 0, events, "hidden event is listed")
+		assert.Equal(t, 0, locs, "location of a hidden event is listed")
+	}
+	// case reads by id leave it out too, but for staff
+	{
+		_, err = db.getEvent(ctx, &geoEvent{ID: res.ID})
+		assert.Equal(t, mgo.ErrNotFound, err)
+		_, err = db.getLoc(ctx, &geoLocation{ID: res.ID})
+		assert.Equal(t, mgo.ErrNotFound, err)
+		events, err := db.getEventsByID(ctx, []bson.ObjectId{res.ID})
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+
+		staff := withViewer(ctx, viewer{Role: "moderator"})
+		_, err = db.getEvent(staff, &geoEvent{ID: res.ID})
+		assert.NoError(t, err)
+		events, err = db.getEventsByID(staff, []bson.ObjectId{res.ID})
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+	}
+	// case an empty patch doesn't give the hidden location away
+	{
+		code, body := patchLocReq(db, res.ID, `{}`)
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Equal(t, geoObject{}, body.Location)
+	}
+	// case a replace keeps the moderation
+	{
+		event := eventRnd()
+		event.ID = res.ID
+		event.Moderation = nil
+		err = db.updateEvent(ctx, &event)
+		if err != nil {
+			t.Error("err updateEvent: ", err)
+		}
+		stored, err := db.getEvent(withViewer(ctx, viewer{Role: "admin"}), &event)
+		if err != nil || stored.Moderation == nil || !stored.Moderation.Hidden {
+			t.Error("err, update dropped the moderation: ", err)
+		}
+	}
+	// case unhide
+	{
+		_, err = db.moderate(ctx, modEvent, res.ID, nil, "unhide")
+		if err != nil {
+			t.Error("err moderate: ", err)
+		}
+		events, locs := listed()
+		assert.Equal(t, 1, events)
+		assert.Equal(t, 1, locs)
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	db, err := dbTest()
+	if err != nil {
+		t.Error("db err: ", err)
+	}
+	ctx := withAudit(context.Background(), auditMeta{
+		Actor: "user:test", Route: "POST /api/v1/users", RequestID: "req-1"})
+
+	user := userRnd()
+	err = db.postUser(ctx, &user)
+	if err != nil {
+		t.Error("err postUser: ", err)
+	}
+	user.Name = "renamed"
+	err = db.updateUser(ctx, &user)
+	if err != nil {
+		t.Error("err updateUser: ", err)
+	}
+	err = db.delUser(ctx, &user)
+	if err != nil {
+		t.Error("err delUser: ", err)
+	}
+
+	entries, err := db.getAudit(ctx, &reqAudit{Kind: "user", Entity: user.ID.Hex(), Limit: 10})
+	if err != nil {
+		t.Error("err getAudit: ", err)
+	}
+	actions := []string{}
+	for _, e := range entries {
+		actions = append(actions, e.Action)
+		assert.Equal(t, "user:test", e.Actor)
+		assert.Equal(t, "req-1", e.RequestID)
+	}
+	// newest first
+	assert.Equal(t, []string{"delete", "update", "create"}, actions)
+
+	byActor, err := db.getAudit(ctx, &reqAudit{Actor: "user:test", Limit: 1000})
+	if err != nil {
+		t.Error("err getAudit: ", err)
+	}
+	assert.True(t, len(byActor) >= 3)
+
+	// case an upsert that updates is diffed against the stored doc
+	ext := "audit-" + randStr(8)
+	events := []geoEvent{{ExternalID: ext, Name: "first"}}
+	_, err = db.postEvents(ctx, events, true)
+	assert.NoError(t, err)
+	events = []geoEvent{{ExternalID: ext, Name: "second"}}
+	_, err = db.postEvents(ctx, events, true)
+	assert.NoError(t, err)
+	entries, err = db.getAudit(ctx, &reqAudit{Kind: "event", Entity: events[0].ID.Hex(), Limit: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Contains(t, entries[0].Changes, auditChange{Field: "name", Before: "first", After: "second"})
+	}
+
+	// case ingest moves are audited
+	device := "audit-" + randStr(8)
+	now := time.Now().UnixMilli()
+	_, err = db.writeFixes(ctx, []fix{{Device: device, TS: now, Lat: 1, Lng: 2}})
+	assert.NoError(t, err)
+	_, err = db.writeFixes(ctx, []fix{{Device: device, TS: now + 1000, Lat: 3, Lng: 4}})
+	assert.NoError(t, err)
+	loc := []geoLocation{}
+	session := db.clone()
+	defer session.Close()
+	assert.NoError(t, session.DB(db.Database).C("dviLocations").Find(bson.M{"external_id": device}).All(&loc))
+	if assert.Len(t, loc, 1) {
+		entries, err = db.getAudit(ctx, &reqAudit{Kind: "location", Entity: loc[0].ID.Hex(), Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+		for _, e := range entries {
+			assert.Equal(t, "move", e.Action)
+		}
+	}
+}
+
+func TestLocationPrivacy(t *testing.T) {
+	db, err := dbTest()
+	if err != nil {
+		t.Error("db err: ", err)
+	}
+	ctx := context.Background()
+
+	user := userRnd()
+	user.Privacy = &privacy{Visibility: "public", Precision: "grid"}
+	err = db.postUser(ctx, &user)
+	if err != nil {
+		t.Error("err postUser: ", err)
+	}
+	point := geoLocation{ID: user.ID, TObject: "User",
+		Location: geoObject{Type: "Point", Coordinates: [2]float64{37.6173, 55.7558}}}
+	_, err = db.postLoc(ctx, &point)
+	if err != nil {
+		t.Error("err postLoc: ", err)
+	}
+	near := reqNear{Scope: 100000, TGeos: "Point", Lat: 55.7558, Lng: 37.6173}
+	found := func(ctx context.Context) (loc *geoLocation) {
+		locs, err := db.getNearLoc(ctx, &near)
+		if err != nil {
+			t.Error("err getNearLoc: ", err)
+		}
+		for i := range locs {
+			if locs[i].ID == user.ID {
+				loc = &locs[i]
+			}
+		}
+		return loc
+	}
+
+	// case others see the center of the grid cell, the owner the point
+	{
+		loc := found(ctx)
+		assert.NotNil(t, loc)
+		assert.Equal(t, db.Privacy.fuzz("grid", point.Location), loc.Location)
+		own := found(withViewer(ctx, viewer{User: user.ID}))
+		assert.Equal(t, point.Location, own.Location)
+		// a patch answers the point as others see it too
+		code, body := patchLocReq(db, user.ID, `{}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, loc.Location, body.Location)
+	}
+	// case only the user and staff change its privacy, others keep it
+	{
+		other := user
+		other.Privacy = &privacy{Visibility: "public", Precision: "exact"}
+		err = db.updateUser(ctx, &other)
+		assert.Equal(t, errNotOwner, err)
+		other.Privacy = nil
+		other.Name = "renamed"
+		err = db.updateUser(ctx, &other)
+		assert.NoError(t, err)
+		assert.Equal(t, user.Privacy, other.Privacy)
+		user.Version = other.Version
+	}
+	// case hidden, only the owner gets it
+	{
+		user.Privacy = &privacy{Visibility: "hidden"}
+		err = db.updateUser(withViewer(ctx, viewer{User: user.ID}), &user)
+		if err != nil {
+			t.Error("err updateUser: ", err)
+		}
+		assert.Nil(t, found(ctx))
+		_, err = db.getLoc(ctx, &point)
+		assert.Equal(t, mgo.ErrNotFound, err)
+		code, body := patchLocReq(db, user.ID, `{}`)
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Equal(t, geoObject{}, body.Location)
+		assert.NotNil(t, found(withViewer(ctx, viewer{User: user.ID})))
+	}
+}
+
+func TestRelations(t *testing.T) {
+	db, err := dbTest()
+	if err != nil {
+		t.Error("db err: ", err)
+	}
+	ctx := context.Background()
+
+	users := make([]geoUser, 3)
+	for i := range users {
+		users[i] = userRnd()
+		err = db.postUser(ctx, &users[i])
+		if err != nil {
+			t.Error("err postUser: ", err)
+		}
+	}
+	a, b, c := users[0].ID, users[1].ID, users[2].ID
+	page := &reqRelations{Limit: 10}
+
+	// case follow twice is one follow
+	{
+		_, err = db.follow(ctx, a, b)
+		assert.NoError(t, err)
+		rel, err := db.follow(ctx, a, b)
+		assert.NoError(t, err)
+		assert.Equal(t, "accepted", rel.Status)
+		followers, err := db.getRelations(ctx, "followers", b, page)
+		assert.NoError(t, err)
+		assert.Len(t, followers, 1)
+	}
+	// case a request is pending until it is sent back
+	{
+		rel, err := db.befriend(ctx, a, b)
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", rel.Status)
+		requests, _ := db.getRelations(ctx, "requests", b, page)
+		assert.Len(t, requests, 1)
+		friends, _ := db.friendIDs(ctx, a, nil)
+		assert.Empty(t, friends)
+
+		rel, err = db.befriend(ctx, b, a)
+		assert.NoError(t, err)
+		assert.Equal(t, "accepted", rel.Status)
+		assert.Equal(t, a, rel.From)
+		friends, _ = db.friendIDs(ctx, b, nil)
+		assert.Equal(t, map[bson.ObjectId]bool{a: true}, friends)
+	}
+	// case requests sent both ways at once are one friendship
+	{
+		done := make(chan error, 2)
+		for _, pair := range [][2]bson.ObjectId{{b, c}, {c, b}} {
+			go func(from, to bson.ObjectId) {
+				_, err := db.befriend(ctx, from, to)
+				done <- err
+			}(pair[0], pair[1])
+		}
+		assert.NoError(t, <-done)
+		assert.NoError(t, <-done)
+		session := db.clone()
+		rels := []relation{}
+		err = session.DB(db.Database).C("dviRelations").Find(friendship(b, c)).All(&rels)
+		session.Close()
+		assert.NoError(t, err)
+		if assert.Len(t, rels, 1) {
+			assert.Equal(t, "accepted", rels[0].Status)
+		}
+		assert.NoError(t, db.unrelate(ctx, "friend", c, b))
+	}
+	// case friends only locations are near for friends alone
+	{
+		users[1].Privacy = &privacy{Visibility: "friends"}
+		err = db.updateUser(withViewer(ctx, viewer{User: b}), &users[1])
+		assert.NoError(t, err)
+		point := geoLocation{ID: b, TObject: "User",
+			Location: geoObject{Type: "Point", Coordinates: [2]float64{2.3522, 48.8566}}}
+		_, err = db.postLoc(ctx, &point)
+		assert.NoError(t, err)
+		near := reqNear{Scope: 1000, TGeos: "Point", Lat: 48.8566, Lng: 2.3522}
+
+		locs, err := db.getFriendsNear(withViewer(ctx, viewer{User: a}), a, &near)
+		assert.NoError(t, err)
+		assert.Len(t, locs, 1)
+		locs, err = db.getFriendsNear(withViewer(ctx, viewer{User: c}), c, &near)
+		assert.NoError(t, err)
+		assert.Len(t, locs, 0)
+		locs, _ = db.getNearLoc(withViewer(ctx, viewer{User: c}), &near)
+		for _, l := range locs {
+			assert.NotEqual(t, b, l.ID, "friends only location seen by a stranger")
+		}
+	}
+	// case unfriend and a deleted user takes its relations along
+	{
+		assert.NoError(t, db.unrelate(ctx, "friend", b, a))
+		friends, _ := db.friendIDs(ctx, a, nil)
+		assert.Empty(t, friends)
+		assert.NoError(t, db.delUser(ctx, &users[0]))
+		followers, _ := db.getRelations(ctx, "followers", b, page)
+		assert.Len(t, followers, 0)
+	}
+}
+
+func TestNotifications(t *testing.T) {
+	db, err := dbTest()
+	if err != nil {
+		t.Error("db err: ", err)
+	}
+	ctx := context.Background()
+	conf := defaultConfig().Notify
+	n := newNotifier(db, &conf)
+	paris := geoObject{Type: "Point", Coordinates: [2]float64{2.3522, 48.8566}}
+
+	users := make([]geoUser, 2)
+	for i := range users {
+		users[i] = userRnd()
+		users[i].Notify = &notifyPrefs{Events: true, Friends: true, Radius: 2000}
+		err = db.postUser(ctx, &users[i])
+		assert.NoError(t, err)
+		home := geoLocation{ID: users[i].ID, TObject: "User", Location: paris}
+		_, err = db.postLoc(ctx, &home)
+		assert.NoError(t, err)
+	}
+	a, b := users[0].ID, users[1].ID
+
+	// drain matches the changes the writes published, twice is still one
+	// notification
+	var changes []change
+	unsubscribe := db.bus.subscribe(ctx, "test", 0, func(_ context.Context, c change) {
+		changes = append(changes, c)
+	})
+	defer unsubscribe()
+	drain := func() {
+		for _, c := range changes {
+			assert.NoError(t, n.match(ctx, c))
+			assert.NoError(t, n.match(ctx, c))
+		}
+		changes = nil
+	}
+
+	// case an event within the radius
+	{
+		gv := reqGeoEvent{Event: eventRnd(), GeoLoc: geoLocation{TObject: "Event",
+			Location: geoObject{Type: "Point", Coordinates: [2]float64{2.36, 48.86}}}}
+		_, err = db.postGeoEvent(ctx, &gv)
+		assert.NoError(t, err)
+		drain()
+		notes, err := db.getNotifications(ctx, a, &reqNotifications{Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, notes, 1)
+		assert.Equal(t, "event_nearby", notes[0].Kind)
+		assert.Equal(t, "pending", notes[0].Status)
+	}
+	// case a friend moves close by
+	{
+		_, err = db.befriend(ctx, a, b)
+		assert.NoError(t, err)
+		_, err = db.befriend(ctx, b, a)
+		assert.NoError(t, err)
+		assert.NoError(t, db.updateLoc(ctx, &geoLocation{ID: b, TObject: "User", Location: paris}))
+		drain()
+		notes, _ := db.getNotifications(ctx, a, &reqNotifications{Limit: 10})
+		assert.Len(t, notes, 2)
+	}
+	// case claimed notes are sent and leased ones are not claimed twice
+	{
+		for {
+			note, err := db.claimNotification(ctx, time.Now(), time.Minute)
+			if err == mgo.ErrNotFound {
+				break
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "sending", note.Status)
+			n.send(ctx, &note)
+		}
+		notes, _ := db.getNotifications(ctx, a, &reqNotifications{Status: "sent", Limit: 10})
+		assert.Len(t, notes, 2)
+		assert.Equal(t, 1, notes[0].Attempts)
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	db, err := dbTest()
+	if err != nil {
+		t.Error("db err: ", err)
+	}
+	ctx := context.Background()
+	conf := defaultConfig().Webhooks
+	conf.MaxAttempts = 2
+	conf.BackoffMin, conf.BackoffMax = time.Millisecond, time.Millisecond
+	d := newDispatcher(db, &conf)
+	var changes []change
+	unsubscribe := db.bus.subscribe(ctx, "test", 0, func(_ context.Context, c change) {
+		changes = append(changes, c)
+	})
+	defer unsubscribe()
+
+	status := http.StatusInternalServerError
+	got := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got++
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	paris, err := createWebhook(ctx, db, &reqWebhook{URL: srv.URL, Events: []string{"event.*"},
+		Area: &hookArea{MinLng: 2, MinLat: 48, MaxLng: 3, MaxLat: 49}}, time.Now())
+	assert.NoError(t, err)
+	_, err = createWebhook(ctx, db, &reqWebhook{URL: srv.URL, Events: []string{"user.deleted"}}, time.Now())
+	assert.NoError(t, err)
+
+	// drain matches what the writes published, twice is still one delivery
+	drain := func() {
+		for _, c := range changes {
+			assert.NoError(t, d.match(ctx, c))
+			assert.NoError(t, d.match(ctx, c))
+		}
+		changes = nil
+	}
+	// send claims every delivery as if it were due
+	send := func() {
+		for {
+			dl, err := db.claimDelivery(ctx, time.Now().Add(time.Hour), time.Minute)
+			if err != nil {
+				break
+			}
+			d.send(ctx, &dl)
+		}
+	}
+
+	inside := reqGeoEvent{Event: eventRnd(), GeoLoc: geoLocation{TObject: "Event",
+		Location: geoObject{Type: "Point", Coordinates: [2]float64{2.35, 48.85}}}}
+	_, err = db.postGeoEvent(ctx, &inside)
+	assert.NoError(t, err)
+	outside := reqGeoEvent{Event: eventRnd(), GeoLoc: geoLocation{TObject: "Event",
+		Location: geoObject{Type: "Point", Coordinates: [2]float64{13.4, 52.5}}}}
+	_, err = db.postGeoEvent(ctx, &outside)
+	assert.NoError(t, err)
+	drain()
+
+	// case failures retry and then go to the dead letters
+	dels, err := db.getDeliveries(ctx, paris.ID, &reqDeliveries{Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, dels, 1)
+	assert.Equal(t, "event.created", dels[0].Type)
+	send()
+	send()
+	assert.Equal(t, 2, got)
+	dels, _ = db.getDeliveries(ctx, paris.ID, &reqDeliveries{Status: "dead", Limit: 10})
+	assert.Len(t, dels, 1)
+	assert.Equal(t, 2, dels[0].Attempts)
+	assert.Equal(t, http.StatusInternalServerError, dels[0].LastStatus)
+
+	// case a replay delivers
+	status = http.StatusOK
+	n, err := db.replayDeliveries(ctx, paris.ID, "", time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	send()
+	dels, _ = db.getDeliveries(ctx, paris.ID, &reqDeliveries{Status: "delivered", Limit: 10})
+	assert.Len(t, dels, 1)
+	_, err = db.replayDeliveries(ctx, paris.ID, bson.NewObjectId(), time.Now())
+	assert.Equal(t, mgo.ErrNotFound, err)
+
+	// case deleting a webhook takes its deliveries along
+	_, err = db.delWebhook(ctx, paris.ID)
+	assert.NoError(t, err)
+	dels, _ = db.getDeliveries(ctx, paris.ID, &reqDeliveries{Limit: 10})
+	assert.Len(t, dels, 0)
+}
+
+func TestMigrations(t *testing.T) {
+	db, err := dbTest()
+	if err != nil {
+		t.Fatal("db err: ", err)
+	}
+	pending, err := db.pending()
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// a db from before migrations has the indexes but no record of them
+	session := db.clone()
+	defer session.Close()
+	_, err = session.DB(db.Database).C("schema_migrations").RemoveAll(nil)
+	assert.NoError(t, err)
+
+	out := &strings.Builder{}
+	all := db.migrations()
+	assert.NoError(t, db.migrate(out, true))
+	assert.True(t, strings.HasPrefix(out.String(), "pending 1 indexes\npending 2 external_ids\n"), out.String())
+	pending, err = db.pending()
+	assert.NoError(t, err)
+	assert.Len(t, pending, len(all))
+
+	out.Reset()
+	assert.NoError(t, db.migrate(out, false))
+	assert.True(t, strings.HasPrefix(out.String(), "applied 1 indexes"), out.String())
+	out.Reset()
+	assert.NoError(t, db.migrate(out, false))
+	assert.Equal(t, "schema is current\n", out.String())
+
+	applied, err := db.appliedMigrations()
+	assert.NoError(t, err)
+	assert.Len(t, applied, len(all))
+	assert.Equal(t, "indexes", applied[0].Name)
+
+	// migrate keeps the data reset drops
+	fillRndToDB(db, 10)
+	assert.NoError(t, db.migrate(io.Discard, false))
+	n, _ := session.DB(db.Database).C("dviUsers").Count()
+	assert.NotZero(t, n)
+
+	// external ids are made unique without touching the data
+	{
+		ext := externalIDMigration(2, "external_ids", "dviTestExternal")
+		c := session.DB(db.Database).C("dviTestExternal")
+		defer c.DropCollection()
+		assert.NoError(t, c.Insert(bson.M{"external_id": "dev-1"}, bson.M{"external_id": "dev-1"}, bson.M{}, bson.M{}))
+		err = ext.Up(session.DB(db.Database))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `"dev-1" (2)`)
+		}
+		n, _ := c.Count()
+		assert.Equal(t, 4, n)
+
+		assert.NoError(t, c.Update(bson.M{"external_id": "dev-1"}, bson.M{"$set": bson.M{"external_id": "dev-2"}}))
+		assert.NoError(t, ext.Up(session.DB(db.Database)))
+		assert.True(t, mgo.IsDup(c.Insert(bson.M{"external_id": "dev-2"})))
+	}
+
+	// requests sent both ways before the pairs are one friendship after
+	{
+		from, to := bson.NewObjectId(), bson.NewObjectId()
+		c := session.DB(db.Database).C("dviRelations")
+		for i, rel := range []relation{
+			{ID: bson.NewObjectId(), Kind: "friend", From: from, To: to, Status: "pending", CreatedAt: time.Now()},
+			{ID: bson.NewObjectId(), Kind: "friend", From: to, To: from, Status: "pending", CreatedAt: time.Now().Add(time.Second)},
+		} {
+			assert.NoError(t, c.Insert(&rel), "relation %d", i)
+		}
+		assert.NoError(t, friendPairMigration(9, "friend_pairs").Up(session.DB(db.Database)))
+		rels := []relation{}
+		assert.NoError(t, c.Find(bson.M{"from": bson.M{"$in": []bson.ObjectId{from, to}}}).All(&rels))
+		if assert.Len(t, rels, 1) {
+			assert.Equal(t, from, rels[0].From)
+			assert.Equal(t, "accepted", rels[0].Status)
+			assert.Equal(t, friendPair(from, to), rels[0].Pair)
+		}
+	}
+
+	assert.Error(t, resetDB(defaultConfig(), io.Discard, false))
+}
+
+// BenchmarkWriteFixes is the db side of ingest, one batch of 1000 devices
+// a write like the ingest writers do
+func BenchmarkWriteFixes(b *testing.B) {
+	db, err := dbTest()
+	if err != nil {
+		b.Fatal("db err: ", err)
+	}
+	batch := make([]fix, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ts := time.Now().UnixMilli()
+		for d := range batch {
+			batch[d] = fix{Device: "bench-" + strconv.Itoa(d), TS: ts, Lat: 55.7, Lng: 37.6}
+		}
+		_, err = db.writeFixes(context.Background(), batch)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(b.N*len(batch))/b.Elapsed().Seconds(), "fixes/s")
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	mgo "gopkg.in/mgo.v2"
+)
+
+// ========== errors
+
+type errKind int
+
+const (
+	kindInternal errKind = iota
+	kindValidation
+	kindUnauthorized
+	kindForbidden
+	kindNotFound
+	kindConflict
+	kindPrecondition
+	kindTooManyRequests
+	kindUnavailable
+)
+
+var kindStatus = map[errKind]int{
+	kindInternal:        http.StatusInternalServerError,
+	kindValidation:      http.StatusBadRequest,
+	kindUnauthorized:    http.StatusUnauthorized,
+	kindForbidden:       http.StatusForbidden,
+	kindNotFound:        http.StatusNotFound,
+	kindConflict:        http.StatusConflict,
+	kindPrecondition:    http.StatusPreconditionFailed,
+	kindTooManyRequests: http.StatusTooManyRequests,
+	kindUnavailable:     http.StatusServiceUnavailable,
+}
+
+// apiError is an error with a status kind and a machine readable code
+type apiError struct {
+	Kind   errKind
+	Code   string
+	Detail string
+	Err    error
+	Fields []fieldError
+}
+
+func (e *apiError) Error() string {
+	if e.Err != nil {
+		return e.Code + ": " + e.Detail + ": " + e.Err.Error()
+	}
+	return e.Code + ": " + e.Detail
+}
+
+func (e *apiError) Unwrap() error {
+	return e.Err
+}
+
+func (e *apiError) Status() int {
+	return kindStatus[e.Kind]
+}
+
+func newAPIError(kind errKind, code, detail string, err error) *apiError {
+	return &apiError{Kind: kind, Code: code, Detail: detail, Err: err}
+}
+
+// errValidation keeps a per-field report when err comes from binding
+func errValidation(code string, err error) *apiError {
+	ae := newAPIError(kindValidation, code, "request is invalid", err)
+	ae.Fields = fieldErrors(err)
+	return ae
+}
+
+func errNotFound(code, detail string) *apiError {
+	return newAPIError(kindNotFound, code, detail, nil)
+}
+
+func errConflict(code, detail string, err error) *apiError {
+	return newAPIError(kindConflict, code, detail, err)
+}
+
+func errUnauthorized(code, detail string) *apiError {
+	return newAPIError(kindUnauthorized, code, detail, nil)
+}
+
+func errForbidden(code, detail string) *apiError {
+	return newAPIError(kindForbidden, code, detail, nil)
+}
+
+// errVersionMismatch is an If-Match or version that is not the stored one
+var errVersionMismatch = newAPIError(kindPrecondition, "version_mismatch",
+	"resource was modified since it was read, fetch it again", nil)
+
+func errUnavailable(code string, err error) *apiError {
+	return newAPIError(kindUnavailable, code, "service is temporarily unavailable", err)
+}
+
+// toAPIError classifies db and binding errors, unknown ones are internal
+func toAPIError(err error) *apiError {
+	var ae *apiError
+	if errors.As(err, &ae) {
+		return ae
+	}
+
+	switch {
+	case err == mgo.ErrNotFound:
+		return newAPIError(kindNotFound, "not_found", "resource not found", nil)
+	case mgo.IsDup(err):
+		return errConflict("duplicate", "resource already exists", err)
+	case isDBUnavailable(err):
+		return errUnavailable("db_unavailable", err)
+	}
+	return newAPIError(kindInternal, "internal", "internal error", err)
+}
+
+func isDBUnavailable(err error) bool {
+	if err == io.EOF {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "no reachable servers") ||
+		strings.Contains(msg, "Closed explicitly")
+}
+
+// ========== problem+json
+
+const problemContentType = "application/problem+json"
+
+// problem is an RFC 7807 error body
+type problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      string       `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []fieldError `json:"errors,omitempty"`
+}
+
+func newProblem(c *gin.Context, ae *apiError) problem {
+	detail := ae.Detail
+	// internal causes stay in the logs
+	if ae.Err != nil && ae.Kind != kindInternal {
+		detail += ": " + ae.Err.Error()
+	}
+	status := ae.Status()
+	return problem{
+		Type:      "urn:geoloc:problem:" + ae.Code,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		Instance:  c.Request.URL.Path,
+		Code:      ae.Code,
+		RequestID: c.GetString("request_id"),
+		Errors:    ae.Fields,
+	}
+}
+
+// abortError writes err as problem+json and stops the handler chain
+func abortError(c *gin.Context, err error) {
+	ae := toAPIError(err)
+	c.Error(err)
+	if ae.Kind == kindUnavailable {
+		c.Header("Retry-After", "5")
+	}
+	c.Header("Content-Type", problemContentType)
+	c.AbortWithStatusJSON(ae.Status(), newProblem(c, ae))
+}
+
+// recoverProblem turns a handler panic into a 500 problem
+func recoverProblem(c *gin.Context, rec interface{}) {
+	abortError(c, newAPIError(kindInternal, "internal", "internal error", nil))
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========== etags
+
+// versionETag is the strong etag of a versioned document
+func versionETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// applyIfMatch sets *version from If-Match, a missing header or "*" keeps it.
+// An etag that is not a version can't match anything, so it's a 412.
+func applyIfMatch(c *gin.Context, version *int64) error {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil
+	}
+	tag := strings.Trim(header, `"`)
+	v, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || strings.HasPrefix(header, "W/") || strings.Contains(header, ",") {
+		return errVersionMismatch
+	}
+	*version = v
+	return nil
+}
+
+// etagMatches does the weak comparison If-None-Match asks for
+func etagMatches(header, etag string) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// cachePrivate marks a body built for its viewer, with members redacted and
+// locations fuzzed for them, so shared caches don't serve it to others and
+// clients keep a copy per credentials
+func cachePrivate(c *gin.Context) {
+	c.Header("Cache-Control", "private")
+	c.Writer.Header().Add("Vary", "Authorization, Cookie, "+apiKeyHeader)
+}
+
+// jsonVersioned answers a single document with its version etag, or 304
+func jsonVersioned(c *gin.Context, version int64, msg string, body interface{}) {
+	etag := versionETag(version)
+	c.Header("ETag", etag)
+	cachePrivate(c)
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"msg": msg, "body": body})
+}
+
+// jsonCached answers with a weak etag of the content, or 304 when the client
+// copy is current, so polling map clients revalidate without a download
+func jsonCached(c *gin.Context, msg string, body interface{}) {
+	data, err := json.Marshal(gin.H{"msg": msg, "body": body})
+	if err != nil {
+		abortError(c, err)
+		return
+	}
+	sum := sha1.Sum(data)
+	etag := `W/"` + hex.EncodeToString(sum[:]) + `"`
+	c.Header("ETag", etag)
+	cachePrivate(c)
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", data)
+}
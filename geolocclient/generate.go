@@ -0,0 +1,5 @@
+package geolocclient
+
+// client.gen.go is generated from the spec the server serves at
+// /api/v1/openapi.json, regenerate it after changing openapi.yaml
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.8.0 -config oapi-codegen.yaml ../openapi.yaml
@@ -0,0 +1,447 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/dataloader/v7"
+	graphql "github.com/graph-gophers/graphql-go"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== graphql
+
+//go:embed schema.graphql
+var graphqlSchema string
+
+type gqlRequest struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func newGraphQLSchema(mongo *mongoDB) *graphql.Schema {
+	return graphql.MustParseSchema(graphqlSchema, &gqlQuery{mongo: mongo},
+		graphql.MaxDepth(8),
+		graphql.MaxParallelism(32),
+	)
+}
+
+// postGraphQL answers in the graphql response format, not the msg/body one
+func postGraphQL(schema *graphql.Schema, mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req gqlRequest
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		ctx := withLoaders(c.Request.Context(), mongo)
+		resp := schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// gqlError keeps the api error code in the graphql error extensions
+type gqlError struct {
+	msg    string
+	code   string
+	fields []fieldError
+}
+
+func (e *gqlError) Error() string {
+	return e.msg
+}
+
+func (e *gqlError) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{"code": e.code}
+	if len(e.fields) > 0 {
+		ext["errors"] = e.fields
+	}
+	return ext
+}
+
+func toGQLError(err error) error {
+	ae := toAPIError(err)
+	msg := ae.Detail
+	// internal causes stay in the logs
+	if ae.Err != nil && ae.Kind != kindInternal {
+		msg += ": " + ae.Err.Error()
+	}
+	return &gqlError{msg: msg, code: ae.Code, fields: ae.Fields}
+}
+
+// ========== graphql loaders
+
+type loadersKey struct{}
+
+// loaders batch the id lookups of one request, fields resolved in parallel
+// within the wait window share a single $in query per collection
+type loaders struct {
+	users  *dataloader.Loader[bson.ObjectId, *geoUser]
+	events *dataloader.Loader[bson.ObjectId, *geoEvent]
+	locs   *dataloader.Loader[bson.ObjectId, *geoLocation]
+}
+
+func withLoaders(ctx context.Context, mongo *mongoDB) context.Context {
+	wait := time.Millisecond
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		users: dataloader.NewBatchedLoader(
+			batchByID(mongo.getUsersByID, func(u *geoUser) bson.ObjectId { return u.ID }),
+			dataloader.WithWait[bson.ObjectId, *geoUser](wait)),
+		events: dataloader.NewBatchedLoader(
+			batchByID(mongo.getEventsByID, func(e *geoEvent) bson.ObjectId { return e.ID }),
+			dataloader.WithWait[bson.ObjectId, *geoEvent](wait)),
+		locs: dataloader.NewBatchedLoader(
+			batchByID(mongo.getLocsByID, func(l *geoLocation) bson.ObjectId { return l.ID }),
+			dataloader.WithWait[bson.ObjectId, *geoLocation](wait)),
+	})
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// batchByID orders the documents of one query as the keys, missing ones
+// are mgo.ErrNotFound
+func batchByID[T any](fetch func(context.Context, []bson.ObjectId) ([]T, error),
+	idOf func(*T) bson.ObjectId) dataloader.BatchFunc[bson.ObjectId, *T] {
+	return func(ctx context.Context, ids []bson.ObjectId) []*dataloader.Result[*T] {
+		results := make([]*dataloader.Result[*T], len(ids))
+		docs, err := fetch(ctx, ids)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*T]{Error: err}
+			}
+			return results
+		}
+		byID := make(map[bson.ObjectId]*T, len(docs))
+		for i := range docs {
+			byID[idOf(&docs[i])] = &docs[i]
+		}
+		for i, id := range ids {
+			if doc, ok := byID[id]; ok {
+				results[i] = &dataloader.Result[*T]{Data: doc}
+			} else {
+				results[i] = &dataloader.Result[*T]{Error: mgo.ErrNotFound}
+			}
+		}
+		return results
+	}
+}
+
+// loadOne is nil without error for a missing document
+func loadOne[T any](ctx context.Context, loader *dataloader.Loader[bson.ObjectId, *T],
+	id bson.ObjectId) (*T, error) {
+	if !id.Valid() {
+		return nil, nil
+	}
+	doc, err := loader.Load(ctx, id)()
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, toGQLError(err)
+	}
+	return doc, nil
+}
+
+// loadRefs loads the documents behind refs, dangling refs are skipped.
+// A ref id is an ObjectId, or its hex as older writes through json stored it.
+func loadRefs[T any](ctx context.Context, loader *dataloader.Loader[bson.ObjectId, *T],
+	refs []mgo.DBRef) ([]*T, error) {
+	ids := make([]bson.ObjectId, 0, len(refs))
+	for _, ref := range refs {
+		switch id := ref.Id.(type) {
+		case bson.ObjectId:
+			if id.Valid() {
+				ids = append(ids, id)
+			}
+		case string:
+			if bson.IsObjectIdHex(id) {
+				ids = append(ids, bson.ObjectIdHex(id))
+			}
+		}
+	}
+	docs, errs := loader.LoadMany(ctx, ids)()
+	found := make([]*T, 0, len(docs))
+	for i, doc := range docs {
+		if errs != nil && errs[i] != nil {
+			if errs[i] == mgo.ErrNotFound {
+				continue
+			}
+			return nil, toGQLError(errs[i])
+		}
+		found = append(found, doc)
+	}
+	return found, nil
+}
+
+// ========== graphql query
+
+type gqlQuery struct {
+	mongo *mongoDB
+}
+
+func gqlID(id graphql.ID) (bson.ObjectId, error) {
+	oid, err := parseID(string(id))
+	if err != nil {
+		return oid, toGQLError(err)
+	}
+	return oid, nil
+}
+
+func (q *gqlQuery) User(ctx context.Context, args struct{ ID graphql.ID }) (*gqlUser, error) {
+	id, err := gqlID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := loadOne(ctx, loadersFrom(ctx).users, id)
+	if u == nil {
+		return nil, err
+	}
+	return &gqlUser{u}, nil
+}
+
+func (q *gqlQuery) Event(ctx context.Context, args struct{ ID graphql.ID }) (*gqlEvent, error) {
+	id, err := gqlID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := loadOne(ctx, loadersFrom(ctx).events, id)
+	if e == nil {
+		return nil, err
+	}
+	return &gqlEvent{e}, nil
+}
+
+func (q *gqlQuery) Location(ctx context.Context, args struct{ ID graphql.ID }) (*gqlLocation, error) {
+	id, err := gqlID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	l, err := loadOne(ctx, loadersFrom(ctx).locs, id)
+	if l == nil {
+		return nil, err
+	}
+	return &gqlLocation{l}, nil
+}
+
+func (q *gqlQuery) Near(ctx context.Context, args struct{ Lat, Lng, Scope float64 }) ([]*gqlLocation, error) {
+	near := reqNear{Scope: args.Scope, TGeos: "Point", Lat: args.Lat, Lng: args.Lng}
+	err := validate(&near)
+	if err != nil {
+		return nil, toGQLError(err)
+	}
+	locs, err := q.mongo.getNearLoc(ctx, &near)
+	if err != nil {
+		return nil, toGQLError(err)
+	}
+	return gqlLocations(locs), nil
+}
+
+func (q *gqlQuery) Filter(ctx context.Context, args struct {
+	Lat, Lng, Scope float64
+	Tobject, Ttime  *string
+	Tags            *[]string
+}) ([]*gqlEventLocation, error) {
+	filter := reqFilter{Scope: args.Scope, Lat: args.Lat, Lng: args.Lng}
+	if args.Tobject != nil {
+		filter.TObject = *args.Tobject
+	}
+	if args.Ttime != nil {
+		filter.TTime = *args.Ttime
+	}
+	if args.Tags != nil {
+		filter.Tags = *args.Tags
+	}
+	err := validate(&filter)
+	if err != nil {
+		return nil, toGQLError(err)
+	}
+	elocs, err := q.mongo.getFiltered(ctx, &filter)
+	if err != nil {
+		return nil, toGQLError(err)
+	}
+	res := make([]*gqlEventLocation, len(elocs))
+	for i := range elocs {
+		res[i] = &gqlEventLocation{&elocs[i]}
+	}
+	return res, nil
+}
+
+func (q *gqlQuery) BBox(ctx context.Context, args struct {
+	MinLng, MinLat, MaxLng, MaxLat float64
+	Limit                          int32
+}) ([]*gqlLocation, error) {
+	box := reqBBox{
+		MinLng: args.MinLng, MinLat: args.MinLat,
+		MaxLng: args.MaxLng, MaxLat: args.MaxLat,
+		Limit: int(args.Limit),
+	}
+	err := validate(&box)
+	if err != nil {
+		return nil, toGQLError(err)
+	}
+	locs, err := q.mongo.getLocsInBox(ctx, &box)
+	if err != nil {
+		return nil, toGQLError(err)
+	}
+	return gqlLocations(locs), nil
+}
+
+// ========== graphql types
+
+func optString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optFloat(f float64) *float64 {
+	if f == 0 {
+		return nil
+	}
+	return &f
+}
+
+func optTime(t time.Time) *graphql.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: t}
+}
+
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+type gqlUser struct{ u *geoUser }
+
+func (r *gqlUser) ID() graphql.ID { return graphql.ID(r.u.ID.Hex()) }
+func (r *gqlUser) Name() *string  { return optString(r.u.Name) }
+func (r *gqlUser) Text() *string  { return optString(r.u.Text) }
+func (r *gqlUser) Tags() []string { return nonNilTags(r.u.Tags) }
+func (r *gqlUser) Email() *string { return optString(r.u.Email) }
+func (r *gqlUser) Version() int32 { return int32(r.u.Version) }
+
+// Location is the location with the id of the user
+func (r *gqlUser) Location(ctx context.Context) (*gqlLocation, error) {
+	l, err := loadOne(ctx, loadersFrom(ctx).locs, r.u.ID)
+	if l == nil {
+		return nil, err
+	}
+	return &gqlLocation{l}, nil
+}
+
+func (r *gqlUser) Events(ctx context.Context) ([]*gqlEvent, error) {
+	events, err := loadRefs(ctx, loadersFrom(ctx).events, r.u.Events)
+	res := make([]*gqlEvent, len(events))
+	for i, e := range events {
+		res[i] = &gqlEvent{e}
+	}
+	return res, err
+}
+
+type gqlEvent struct{ e *geoEvent }
+
+func (r *gqlEvent) ID() graphql.ID           { return graphql.ID(r.e.ID.Hex()) }
+func (r *gqlEvent) Name() *string            { return optString(r.e.Name) }
+func (r *gqlEvent) Text() *string            { return optString(r.e.Text) }
+func (r *gqlEvent) Tags() []string           { return nonNilTags(r.e.Tags) }
+func (r *gqlEvent) TTL() *graphql.Time       { return optTime(r.e.TTLEvent) }
+func (r *gqlEvent) Timestamp() *graphql.Time { return optTime(r.e.Timestamp) }
+func (r *gqlEvent) ExternalID() *string      { return optString(r.e.ExternalID) }
+func (r *gqlEvent) Version() int32           { return int32(r.e.Version) }
+
+// Location is the location with the id of the event
+func (r *gqlEvent) Location(ctx context.Context) (*gqlLocation, error) {
+	l, err := loadOne(ctx, loadersFrom(ctx).locs, r.e.ID)
+	if l == nil {
+		return nil, err
+	}
+	return &gqlLocation{l}, nil
+}
+
+func (r *gqlEvent) Participants(ctx context.Context) ([]*gqlUser, error) {
+	users, err := loadRefs(ctx, loadersFrom(ctx).users, r.e.Users)
+	res := make([]*gqlUser, len(users))
+	for i, u := range users {
+		res[i] = &gqlUser{u}
+	}
+	return res, err
+}
+
+type gqlLocation struct{ l *geoLocation }
+
+func gqlLocations(locs []geoLocation) []*gqlLocation {
+	res := make([]*gqlLocation, len(locs))
+	for i := range locs {
+		res[i] = &gqlLocation{&locs[i]}
+	}
+	return res
+}
+
+func (r *gqlLocation) ID() graphql.ID       { return graphql.ID(r.l.ID.Hex()) }
+func (r *gqlLocation) Tobject() *string     { return optString(r.l.TObject) }
+func (r *gqlLocation) Lng() float64         { return r.l.Location.Coordinates[0] }
+func (r *gqlLocation) Lat() float64         { return r.l.Location.Coordinates[1] }
+func (r *gqlLocation) ExternalID() *string  { return optString(r.l.ExternalID) }
+func (r *gqlLocation) FixTs() *graphql.Time { return optTime(r.l.FixTime) }
+func (r *gqlLocation) Accuracy() *float64   { return optFloat(r.l.Accuracy) }
+func (r *gqlLocation) Speed() *float64      { return optFloat(r.l.Speed) }
+func (r *gqlLocation) Version() int32       { return int32(r.l.Version) }
+
+func (r *gqlLocation) User(ctx context.Context) (*gqlUser, error) {
+	if r.l.TObject != "User" {
+		return nil, nil
+	}
+	u, err := loadOne(ctx, loadersFrom(ctx).users, r.l.ID)
+	if u == nil {
+		return nil, err
+	}
+	return &gqlUser{u}, nil
+}
+
+func (r *gqlLocation) Event(ctx context.Context) (*gqlEvent, error) {
+	if r.l.TObject != "Event" {
+		return nil, nil
+	}
+	e, err := loadOne(ctx, loadersFrom(ctx).events, r.l.ID)
+	if e == nil {
+		return nil, err
+	}
+	return &gqlEvent{e}, nil
+}
+
+type gqlEventLocation struct{ el *eventLoc }
+
+func (r *gqlEventLocation) ID() graphql.ID           { return graphql.ID(r.el.ID.Hex()) }
+func (r *gqlEventLocation) Name() *string            { return optString(r.el.Name) }
+func (r *gqlEventLocation) Text() *string            { return optString(r.el.Text) }
+func (r *gqlEventLocation) Tags() []string           { return nonNilTags(r.el.Tags) }
+func (r *gqlEventLocation) Tobject() *string         { return optString(r.el.TObject) }
+func (r *gqlEventLocation) Timestamp() *graphql.Time { return optTime(r.el.Timestamp) }
+func (r *gqlEventLocation) Lng() float64             { return r.el.Location.Coordinates[0] }
+func (r *gqlEventLocation) Lat() float64             { return r.el.Location.Coordinates[1] }
+
+func (r *gqlEventLocation) Event(ctx context.Context) (*gqlEvent, error) {
+	return (&gqlLocation{&geoLocation{ID: r.el.ID, TObject: r.el.TObject}}).Event(ctx)
+}
+
+func (r *gqlEventLocation) Location(ctx context.Context) (*gqlLocation, error) {
+	l, err := loadOne(ctx, loadersFrom(ctx).locs, r.el.ID)
+	if l == nil {
+		return nil, err
+	}
+	return &gqlLocation{l}, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/stretchr/testify/assert"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestGraphQL(t *testing.T) {
+	conf := defaultConfig()
+	testRouter := newTestRouter(conf, &mongoDB{})
+
+	cases := []struct {
+		query string
+		code  string
+	}{
+		{`{ near(lat: 100, lng: 0, scope: 10) { id } }`, "invalid_request"},
+		{`{ bbox(minLng: 10, minLat: 0, maxLng: 5, maxLat: 1) { id } }`, "invalid_request"},
+		{`{ user(id: "nope") { id } }`, "invalid_id"},
+	}
+	for _, cs := range cases {
+		body, _ := json.Marshal(gqlRequest{Query: cs.query})
+		req, _ := http.NewRequest("POST", "/api/v1/graphql", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		response := httptest.NewRecorder()
+		testRouter.ServeHTTP(response, req)
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		res := struct {
+			Errors []struct {
+				Extensions struct {
+					Code string `json:"code"`
+				} `json:"extensions"`
+			} `json:"errors"`
+		}{}
+		json.Unmarshal(response.Body.Bytes(), &res)
+		if assert.Len(t, res.Errors, 1, cs.query) {
+			assert.Equal(t, cs.code, res.Errors[0].Extensions.Code, cs.query)
+		}
+	}
+}
+
+func TestGraphQLLoader(t *testing.T) {
+	a, b, missing := bson.NewObjectId(), bson.NewObjectId(), bson.NewObjectId()
+	calls := 0
+	fetch := func(ctx context.Context, ids []bson.ObjectId) ([]geoUser, error) {
+		calls++
+		// documents come back in any order
+		return []geoUser{{ID: b, Name: "b"}, {ID: a, Name: "a"}}, nil
+	}
+	loader := dataloader.NewBatchedLoader(
+		batchByID(fetch, func(u *geoUser) bson.ObjectId { return u.ID }),
+		dataloader.WithWait[bson.ObjectId, *geoUser](10*time.Millisecond))
+
+	ctx := context.Background()
+	users, err := loadRefs(ctx, loader, []mgo.DBRef{
+		{Collection: "dviUsers", Id: a},
+		{Collection: "dviUsers", Id: missing},
+		{Collection: "dviUsers", Id: b.Hex()},
+		{Collection: "dviUsers", Id: "nope"},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, users, 2) {
+		assert.Equal(t, "a", users[0].Name)
+		assert.Equal(t, "b", users[1].Name)
+	}
+	assert.Equal(t, 1, calls)
+
+	u, err := loadOne(ctx, loader, missing)
+	assert.NoError(t, err)
+	assert.Nil(t, u)
+}
@@ -0,0 +1,780 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/mgo.v2/bson"
+
+	pb "github.com/asm-jaime/dvij.geoloc/geolocpb"
+)
+
+// ========== grpc server
+
+// grpcServer serves geolocpb.Geoloc from the same mongoDB methods and
+// binding rules as the REST handlers
+type grpcServer struct {
+	pb.UnimplementedGeolocServer
+	mongo *mongoDB
+	conf  *grpcConfig
+	// done is closed when shutdown starts, so watch streams end
+	done <-chan struct{}
+}
+
+func newGRPCServer(base context.Context, conf *config, mongo *mongoDB, ring *keyring, limits limitStore) *grpc.Server {
+	auth := &grpcAuth{auth: &conf.Auth, limits: &conf.RateLimit, ring: ring, store: limits}
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryLogger, unaryRecover, unaryDB(mongo), auth.unary),
+		grpc.ChainStreamInterceptor(streamLogger, streamRecover, streamDB(mongo), auth.stream),
+	)
+	pb.RegisterGeolocServer(srv, &grpcServer{mongo: mongo, conf: &conf.GRPC, done: base.Done()})
+	reflection.Register(srv)
+	return srv
+}
+
+var grpcCodes = map[errKind]codes.Code{
+	kindInternal:        codes.Internal,
+	kindValidation:      codes.InvalidArgument,
+	kindUnauthorized:    codes.Unauthenticated,
+	kindForbidden:       codes.PermissionDenied,
+	kindNotFound:        codes.NotFound,
+	kindConflict:        codes.AlreadyExists,
+	kindPrecondition:    codes.FailedPrecondition,
+	kindTooManyRequests: codes.ResourceExhausted,
+	kindUnavailable:     codes.Unavailable,
+}
+
+// grpcError is abortError for grpc, field errors go in a BadRequest detail
+func grpcError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	ae := toAPIError(err)
+	msg := ae.Code + ": " + ae.Detail
+	// internal causes stay in the logs
+	if ae.Err != nil && ae.Kind != kindInternal {
+		msg += ": " + ae.Err.Error()
+	}
+	st := status.New(grpcCodes[ae.Kind], msg)
+	if len(ae.Fields) > 0 {
+		br := &errdetails.BadRequest{}
+		for _, fe := range ae.Fields {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fe.Field,
+				Description: fe.Message,
+			})
+		}
+		if withDetails, err := st.WithDetails(br); err == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
+
+// ========== grpc interceptors
+
+func grpcRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get("x-request-id"); len(ids) > 0 && len(ids[0]) <= 64 {
+		return ids[0]
+	}
+	return bson.NewObjectId().Hex()
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	attrs := []slog.Attr{
+		slog.String("request_id", grpcRequestID(ctx)),
+		slog.String("rpc", method),
+		slog.String("code", status.Code(err).String()),
+		slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+	}
+	level := slog.LevelInfo
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		if status.Code(err) == codes.Internal || status.Code(err) == codes.Unknown {
+			level = slog.LevelError
+		}
+	}
+	slog.LogAttrs(ctx, level, "rpc", attrs...)
+}
+
+func unaryLogger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logRPC(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+func streamLogger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logRPC(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+func unaryRecover(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			slog.Error("rpc panic", "rpc", info.FullMethod, "panic", rec)
+			err = status.Error(codes.Internal, "internal: internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func streamRecover(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			slog.Error("rpc panic", "rpc", info.FullMethod, "panic", rec)
+			err = status.Error(codes.Internal, "internal: internal error")
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// unaryDB is middlewareDB for grpc
+func unaryDB(mongo *mongoDB) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if _, err := mongo.health.get(); err != nil {
+			return nil, grpcError(errUnavailable("db_unavailable", err))
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamDB(mongo *mongoDB) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if _, err := mongo.health.get(); err != nil {
+			return grpcError(errUnavailable("db_unavailable", err))
+		}
+		return handler(srv, ss)
+	}
+}
+
+// ========== grpc auth
+
+// grpcScopes is routeScopes for the rpcs, methods not listed here, like
+// server reflection, need no scope
+var grpcScopes = map[string][]string{
+	pb.Geoloc_ListUsers_FullMethodName:      {"read:users"},
+	pb.Geoloc_GetUser_FullMethodName:        {"read:users"},
+	pb.Geoloc_CreateUser_FullMethodName:     {"write:users"},
+	pb.Geoloc_UpdateUser_FullMethodName:     {"write:users"},
+	pb.Geoloc_DeleteUser_FullMethodName:     {"write:users"},
+	pb.Geoloc_ListEvents_FullMethodName:     {"read:events"},
+	pb.Geoloc_GetEvent_FullMethodName:       {"read:events"},
+	pb.Geoloc_CreateEvent_FullMethodName:    {"write:events"},
+	pb.Geoloc_UpdateEvent_FullMethodName:    {"write:events"},
+	pb.Geoloc_DeleteEvent_FullMethodName:    {"write:events"},
+	pb.Geoloc_ListLocations_FullMethodName:  {"read:locs"},
+	pb.Geoloc_GetLocation_FullMethodName:    {"read:locs"},
+	pb.Geoloc_CreateLocation_FullMethodName: {"write:locs"},
+	pb.Geoloc_UpdateLocation_FullMethodName: {"write:locs"},
+	pb.Geoloc_DeleteLocation_FullMethodName: {"write:locs"},
+	pb.Geoloc_CreateGeoEvent_FullMethodName: {"write:events", "write:locs"},
+	pb.Geoloc_Near_FullMethodName:           {"read:locs"},
+	pb.Geoloc_Filter_FullMethodName:         {"read:locs"},
+	pb.Geoloc_WatchNear_FullMethodName:      {"read:locs"},
+}
+
+// grpcAuth is middlewareAPIKey, middlewareRateLimit, middlewareAudit and
+// middlewareViewer for grpc, the key comes in the x-api-key metadata
+type grpcAuth struct {
+	auth   *authConfig
+	limits *rateLimitConfig
+	ring   *keyring
+	store  limitStore
+}
+
+// authorize checks the key of an rpc and takes a token for it, ctx comes
+// back with the viewer and audit meta. header is the rate limit metadata.
+func (a *grpcAuth) authorize(ctx context.Context, method string) (_ context.Context, header metadata.MD, err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	raw := ""
+	if keys := md.Get(apiKeyHeader); len(keys) > 0 {
+		raw = keys[0]
+	}
+	ip := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	scopes := grpcScopes[method]
+
+	v := viewer{}
+	keyID, userID := "", ""
+	if raw == "" {
+		if a.auth.Required {
+			return ctx, nil, errKeyRequired
+		}
+	} else {
+		key, owner, err := a.ring.authenticate(ctx, raw, ip, scopes, time.Now())
+		if err != nil {
+			// a key that fails takes from the budget of the ip
+			header, limited := a.limit(ctx, method, scopes, limitIdentity("", "", ip))
+			if limited != nil {
+				return ctx, header, limited
+			}
+			return ctx, header, err
+		}
+		keyID, v.Role = key.ID.Hex(), keyRole(&key, &owner)
+		if owner.ID != "" {
+			userID, v.User = owner.ID.Hex(), owner.ID
+		}
+	}
+
+	header, err = a.limit(ctx, method, scopes, limitIdentity(keyID, userID, ip))
+	if err != nil {
+		return ctx, header, err
+	}
+
+	ctx = withAudit(ctx, auditMeta{
+		Actor:     keyActor(keyID, userID, "grpc"),
+		Route:     method,
+		RequestID: grpcRequestID(ctx),
+	})
+	return withViewer(ctx, v), header, nil
+}
+
+// limit takes a token of who for an rpc, header is the rate limit metadata
+// and err errRateLimited when the bucket is empty
+func (a *grpcAuth) limit(ctx context.Context, method string, scopes []string, who string) (header metadata.MD, err error) {
+	if !a.limits.Enabled {
+		return nil, nil
+	}
+	class, budget := grpcLimitClass(a.limits, method, scopes)
+	headers, err := takeLimit(ctx, a.store, class, budget, who)
+	if err != nil && err != errRateLimited {
+		slog.WarnContext(ctx, "rate limit store", "error", err.Error())
+		return nil, nil
+	}
+	header = metadata.MD{}
+	for name, value := range headers {
+		header.Set(name, value)
+	}
+	return header, err
+}
+
+// grpcLimitClass is limitClass for an rpc, expensive_routes may name rpcs
+func grpcLimitClass(conf *rateLimitConfig, method string, scopes []string) (string, limitBudget) {
+	switch {
+	case contains(conf.ExpensiveRoutes, method):
+		return "expensive", conf.Expensive
+	case len(scopes) > 0 && strings.HasPrefix(scopes[0], "read:"):
+		return "read", conf.Read
+	}
+	return "write", conf.Write
+}
+
+func (a *grpcAuth) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, header, err := a.authorize(ctx, info.FullMethod)
+	if header != nil {
+		grpc.SetHeader(ctx, header)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return handler(ctx, req)
+}
+
+func (a *grpcAuth) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx, header, err := a.authorize(ss.Context(), info.FullMethod)
+	if header != nil {
+		ss.SetHeader(header)
+	}
+	if err != nil {
+		return grpcError(err)
+	}
+	return handler(srv, &authedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authedStream is a stream with the context authorize returned
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authedStream) Context() context.Context {
+	return s.ctx
+}
+
+// ========== grpc conversions
+
+func timeToPB(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timeFromPB(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// optionalID parses an id that may be empty, as on create
+func optionalID(hex string) (bson.ObjectId, error) {
+	if hex == "" {
+		return "", nil
+	}
+	return parseID(hex)
+}
+
+func userToPB(u *geoUser) *pb.User {
+	return &pb.User{
+		Id:      u.ID.Hex(),
+		Name:    u.Name,
+		Text:    u.Text,
+		Tags:    u.Tags,
+		Email:   u.Email,
+		Version: u.Version,
+	}
+}
+
+func userFromPB(u *pb.User) (geoUser, error) {
+	id, err := optionalID(u.GetId())
+	return geoUser{
+		ID:      id,
+		Name:    u.GetName(),
+		Text:    u.GetText(),
+		Tags:    u.GetTags(),
+		Email:   u.GetEmail(),
+		Version: u.GetVersion(),
+	}, err
+}
+
+func eventToPB(e *geoEvent) *pb.Event {
+	return &pb.Event{
+		Id:         e.ID.Hex(),
+		Name:       e.Name,
+		Text:       e.Text,
+		Tags:       e.Tags,
+		Ttl:        timeToPB(e.TTLEvent),
+		Timestamp:  timeToPB(e.Timestamp),
+		ExternalId: e.ExternalID,
+		Version:    e.Version,
+	}
+}
+
+func eventFromPB(e *pb.Event) (geoEvent, error) {
+	id, err := optionalID(e.GetId())
+	return geoEvent{
+		ID:         id,
+		Name:       e.GetName(),
+		Text:       e.GetText(),
+		Tags:       e.GetTags(),
+		TTLEvent:   timeFromPB(e.GetTtl()),
+		Timestamp:  timeFromPB(e.GetTimestamp()),
+		ExternalID: e.GetExternalId(),
+		Version:    e.GetVersion(),
+	}, err
+}
+
+func pointToPB(g geoObject) *pb.Point {
+	return &pb.Point{Lng: g.Coordinates[0], Lat: g.Coordinates[1]}
+}
+
+func locToPB(l *geoLocation) *pb.Location {
+	return &pb.Location{
+		Id:         l.ID.Hex(),
+		Tobject:    l.TObject,
+		Location:   pointToPB(l.Location),
+		ExternalId: l.ExternalID,
+		FixTs:      timeToPB(l.FixTime),
+		Accuracy:   l.Accuracy,
+		Speed:      l.Speed,
+		Version:    l.Version,
+	}
+}
+
+func locFromPB(l *pb.Location) (geoLocation, error) {
+	id, err := optionalID(l.GetId())
+	loc := geoLocation{
+		ID:         id,
+		TObject:    l.GetTobject(),
+		ExternalID: l.GetExternalId(),
+		FixTime:    timeFromPB(l.GetFixTs()),
+		Accuracy:   l.GetAccuracy(),
+		Speed:      l.GetSpeed(),
+		Version:    l.GetVersion(),
+	}
+	if p := l.GetLocation(); p != nil {
+		loc.Location = geoObject{Type: "Point", Coordinates: [2]float64{p.GetLng(), p.GetLat()}}
+	}
+	return loc, err
+}
+
+func locsToPB(locs []geoLocation) *pb.Locations {
+	res := &pb.Locations{}
+	for i := range locs {
+		res.Locations = append(res.Locations, locToPB(&locs[i]))
+	}
+	return res
+}
+
+func nearFromPB(req *pb.NearRequest) reqNear {
+	return reqNear{Scope: req.GetScope(), TGeos: "Point", Lat: req.GetLat(), Lng: req.GetLng()}
+}
+
+// ========== grpc user
+
+func (s *grpcServer) ListUsers(ctx context.Context, req *pb.ListRequest) (*pb.Users, error) {
+	users, err := s.mongo.getUsers(ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &pb.Users{}
+	for i := range users {
+		res.Users = append(res.Users, userToPB(&users[i]))
+	}
+	return res, nil
+}
+
+func (s *grpcServer) GetUser(ctx context.Context, req *pb.GetRequest) (*pb.User, error) {
+	u := geoUser{Email: req.GetEmail()}
+	if u.Email == "" {
+		id, err := parseID(req.GetId())
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		u.ID = id
+	}
+	user, err := s.mongo.getUser(ctx, &u)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return userToPB(&user), nil
+}
+
+func (s *grpcServer) CreateUser(ctx context.Context, req *pb.User) (*pb.User, error) {
+	user, err := userFromPB(req)
+	if err == nil {
+		err = validate(&user)
+	}
+	if err == nil {
+		err = s.mongo.postUser(ctx, &user)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return userToPB(&user), nil
+}
+
+func (s *grpcServer) UpdateUser(ctx context.Context, req *pb.User) (*pb.User, error) {
+	user, err := userFromPB(req)
+	if err == nil {
+		_, err = parseID(req.GetId())
+	}
+	if err == nil {
+		err = validate(&user)
+	}
+	if err == nil {
+		// the proto has no events, privacy or notify, keep the stored ones
+		var stored geoUser
+		stored, err = s.mongo.getUser(ctx, &geoUser{ID: user.ID})
+		user.Events, user.Privacy, user.Notify = stored.Events, stored.Privacy, stored.Notify
+	}
+	if err == nil {
+		err = s.mongo.updateUser(ctx, &user)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return userToPB(&user), nil
+}
+
+func (s *grpcServer) DeleteUser(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	id, err := parseID(req.GetId())
+	if err == nil {
+		err = s.mongo.delUser(ctx, &geoUser{ID: id})
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.DeleteResponse{}, nil
+}
+
+// ========== grpc event
+
+func (s *grpcServer) ListEvents(ctx context.Context, req *pb.ListRequest) (*pb.Events, error) {
+	events, err := s.mongo.getEvents(ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &pb.Events{}
+	for i := range events {
+		res.Events = append(res.Events, eventToPB(&events[i]))
+	}
+	return res, nil
+}
+
+func (s *grpcServer) GetEvent(ctx context.Context, req *pb.GetRequest) (*pb.Event, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	event, err := s.mongo.getEvent(ctx, &geoEvent{ID: id})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToPB(&event), nil
+}
+
+func (s *grpcServer) CreateEvent(ctx context.Context, req *pb.Event) (*pb.Event, error) {
+	event, err := eventFromPB(req)
+	if err == nil {
+		err = validate(&event)
+	}
+	if err == nil {
+		err = s.mongo.postEvent(ctx, &event)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToPB(&event), nil
+}
+
+func (s *grpcServer) UpdateEvent(ctx context.Context, req *pb.Event) (*pb.Event, error) {
+	event, err := eventFromPB(req)
+	if err == nil {
+		_, err = parseID(req.GetId())
+	}
+	if err == nil {
+		err = validate(&event)
+	}
+	if err == nil {
+		// the proto has no users, keep the stored ones
+		var stored geoEvent
+		stored, err = s.mongo.getEvent(ctx, &geoEvent{ID: event.ID})
+		event.Users = stored.Users
+	}
+	if err == nil {
+		err = s.mongo.updateEvent(ctx, &event)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToPB(&event), nil
+}
+
+func (s *grpcServer) DeleteEvent(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	id, err := parseID(req.GetId())
+	if err == nil {
+		err = s.mongo.delEvent(ctx, &geoEvent{ID: id})
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.DeleteResponse{}, nil
+}
+
+// ========== grpc locations
+
+func (s *grpcServer) ListLocations(ctx context.Context, req *pb.ListRequest) (*pb.Locations, error) {
+	locs, err := s.mongo.getLocs(ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return locsToPB(locs), nil
+}
+
+func (s *grpcServer) GetLocation(ctx context.Context, req *pb.GetRequest) (*pb.Location, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	loc, err := s.mongo.getLoc(ctx, &geoLocation{ID: id})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return locToPB(&loc), nil
+}
+
+func (s *grpcServer) CreateLocation(ctx context.Context, req *pb.Location) (*pb.Location, error) {
+	loc, err := locFromPB(req)
+	if err == nil {
+		err = validate(&loc)
+	}
+	if err == nil {
+		_, err = s.mongo.postLoc(ctx, &loc)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return locToPB(&loc), nil
+}
+
+func (s *grpcServer) UpdateLocation(ctx context.Context, req *pb.Location) (*pb.Location, error) {
+	loc, err := locFromPB(req)
+	if err == nil {
+		_, err = parseID(req.GetId())
+	}
+	if err == nil {
+		err = validate(&loc)
+	}
+	if err == nil {
+		err = s.mongo.updateLoc(ctx, &loc)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return locToPB(&loc), nil
+}
+
+func (s *grpcServer) DeleteLocation(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	id, err := parseID(req.GetId())
+	if err == nil {
+		err = s.mongo.delLoc(ctx, &geoLocation{ID: id})
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.DeleteResponse{}, nil
+}
+
+// ========== grpc geo queries
+
+func (s *grpcServer) CreateGeoEvent(ctx context.Context, req *pb.GeoEventRequest) (*pb.GeoEventResponse, error) {
+	var gv reqGeoEvent
+	var err error
+	gv.GeoLoc, err = locFromPB(req.GetLocation())
+	if err == nil {
+		gv.Event, err = eventFromPB(req.GetEvent())
+	}
+	if err == nil {
+		err = validate(&gv)
+	}
+	var res respondID
+	if err == nil {
+		res, err = s.mongo.postGeoEvent(ctx, &gv)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.GeoEventResponse{Id: res.ID.Hex()}, nil
+}
+
+func (s *grpcServer) Near(ctx context.Context, req *pb.NearRequest) (*pb.Locations, error) {
+	near := nearFromPB(req)
+	err := validate(&near)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	locs, err := s.mongo.getNearLoc(ctx, &near)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return locsToPB(locs), nil
+}
+
+func (s *grpcServer) Filter(ctx context.Context, req *pb.FilterRequest) (*pb.EventLocations, error) {
+	filter := reqFilter{
+		TObject: req.GetTobject(),
+		Scope:   req.GetScope(),
+		TTime:   req.GetTtime(),
+		Tags:    req.GetTags(),
+		Lat:     req.GetLat(),
+		Lng:     req.GetLng(),
+	}
+	err := validate(&filter)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	elocs, err := s.mongo.getFiltered(ctx, &filter)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &pb.EventLocations{}
+	for _, el := range elocs {
+		res.EventLocations = append(res.EventLocations, &pb.EventLocation{
+			Id:        el.ID.Hex(),
+			Name:      el.Name,
+			Text:      el.Text,
+			Tags:      el.Tags,
+			Tobject:   el.TObject,
+			Timestamp: timeToPB(el.Timestamp),
+			Location:  pointToPB(el.Location),
+		})
+	}
+	return res, nil
+}
+
+// WatchNear polls the area every WatchInterval, or sooner when a location
+// changes, and sends the locations that are new to it or changed version
+// since the last poll
+func (s *grpcServer) WatchNear(req *pb.NearRequest, stream grpc.ServerStreamingServer[pb.Location]) error {
+	near := nearFromPB(req)
+	err := validate(&near)
+	if err != nil {
+		return grpcError(err)
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(s.conf.WatchInterval)
+	defer ticker.Stop()
+	// a change only wakes the poll, many of them are one poll
+	wake := make(chan struct{}, 1)
+	unsubscribe := s.mongo.bus.subscribe(ctx, "watch", 0, func(_ context.Context, c change) {
+		if c.Kind != "location" {
+			return
+		}
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	})
+	defer unsubscribe()
+	sent := map[bson.ObjectId]int64{}
+	for {
+		locs, err := s.mongo.getNearLoc(ctx, &near)
+		if err != nil {
+			return grpcError(err)
+		}
+		seen := make(map[bson.ObjectId]int64, len(locs))
+		for i := range locs {
+			seen[locs[i].ID] = locs[i].Version
+			if v, ok := sent[locs[i].ID]; ok && v == locs[i].Version {
+				continue
+			}
+			err = stream.Send(locToPB(&locs[i]))
+			if err != nil {
+				return err
+			}
+		}
+		// locations that left the area are sent again if they come back
+		sent = seen
+
+		select {
+		case <-ctx.Done():
+			return grpcError(ctx.Err())
+		case <-s.done:
+			return status.Error(codes.Unavailable, "shutting_down: server is shutting down")
+		case <-ticker.C:
+		case <-wake:
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	pb "github.com/asm-jaime/dvij.geoloc/geolocpb"
+)
+
+// dialGRPC serves srv on an in-memory listener, stop undoes it
+func dialGRPC(t *testing.T, srv *grpc.Server) (client pb.GeolocClient, stop func()) {
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	return pb.NewGeolocClient(conn), func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	conf := defaultConfig()
+	setupValidation(false)
+	db := &mongoDB{}
+	client, stop := dialGRPC(t, newGRPCServer(context.Background(), conf, db,
+		newKeyring(db, &conf.Auth), newLimitStore(&conf.RateLimit)))
+	defer stop()
+	ctx := context.Background()
+
+	// binding rules are shared with the REST api
+	_, err := client.Near(ctx, &pb.NearRequest{Lat: 100, Lng: 10, Scope: 0})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	fields := []string{}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, fv := range br.FieldViolations {
+				fields = append(fields, fv.Field)
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"scope", "lat"}, fields)
+
+	_, err = client.GetLocation(ctx, &pb.GetRequest{Id: "nope"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateLocation(ctx, &pb.Location{
+		Tobject: "Car", Location: &pb.Point{Lng: 200, Lat: 0}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCError(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{errNotFound("not_found", "x"), codes.NotFound},
+		{errVersionMismatch, codes.FailedPrecondition},
+		{errConflict("duplicate", "x", nil), codes.AlreadyExists},
+		{context.Canceled, codes.Canceled},
+		{assert.AnError, codes.Internal},
+	}
+	for _, cs := range cases {
+		assert.Equal(t, cs.code, status.Code(grpcError(cs.err)), cs.err.Error())
+	}
+}
+
+func TestGRPCAuth(t *testing.T) {
+	conf := defaultConfig()
+	conf.Auth.Required = true
+	conf.RateLimit.Read = limitBudget{Rate: 1, Burst: 2}
+	setupValidation(false)
+
+	raw, prefix, hash, err := newKeyMaterial()
+	assert.NoError(t, err)
+	stored := apiKey{ID: bson.NewObjectId(), Prefix: prefix, Hash: hash, Scopes: []string{"read:locs"}}
+	db := &mongoDB{}
+	ring := newKeyring(db, &conf.Auth)
+	ring.find = func(ctx context.Context, p string) (apiKey, error) {
+		if p != stored.Prefix {
+			return apiKey{}, mgo.ErrNotFound
+		}
+		return stored, nil
+	}
+	ring.touch = func(ctx context.Context, id bson.ObjectId, at time.Time, ip string) error {
+		return nil
+	}
+	client, stop := dialGRPC(t, newGRPCServer(context.Background(), conf, db, ring, newMemoryStore()))
+	defer stop()
+	ctx := context.Background()
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, "x-api-key", key)
+	}
+	// a bad near passes the key and fails validation
+	badNear := &pb.NearRequest{Lat: 100, Scope: 1}
+
+	_, err = client.Near(ctx, badNear)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Near(withKey("gk_made_up"), badNear)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.CreateLocation(withKey(raw), &pb.Location{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the key has a budget of its own, made up keys take from the ip's
+	var header metadata.MD
+	_, err = client.Near(withKey(raw), badNear, grpc.Header(&header))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []string{"2"}, header.Get("ratelimit-limit"))
+	_, err = client.Near(withKey(raw), badNear)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.Near(withKey(raw), badNear, grpc.Header(&header))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"1"}, header.Get("retry-after"))
+	_, err = client.Near(withKey("gk_made_up"), badNear)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Near(withKey("gk_made_up"), badNear)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// every rpc of the service names its scopes
+	for _, m := range pb.Geoloc_ServiceDesc.Methods {
+		assert.NotEmpty(t, grpcScopes["/"+pb.Geoloc_ServiceDesc.ServiceName+"/"+m.MethodName], m.MethodName)
+	}
+	for _, m := range pb.Geoloc_ServiceDesc.Streams {
+		assert.NotEmpty(t, grpcScopes["/"+pb.Geoloc_ServiceDesc.ServiceName+"/"+m.StreamName], m.StreamName)
+	}
+}
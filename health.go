@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========== health
+
+// dbHealth caches the results of the background db probe, so requests don't
+// query mongo themselves
+type dbHealth struct {
+	mu      sync.RWMutex
+	err     error
+	checked time.Time
+	// schema is the last result of each schemaChecks check, by name
+	schema map[string]error
+}
+
+func (h *dbHealth) set(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.err = err
+	h.checked = time.Now()
+}
+
+func (h *dbHealth) get() (checked time.Time, err error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.checked, h.err
+}
+
+func (h *dbHealth) setSchema(schema map[string]error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.schema = schema
+}
+
+var errNotChecked = errors.New("not checked yet")
+
+func (h *dbHealth) getSchema(name string) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	err, ok := h.schema[name]
+	if !ok {
+		return errNotChecked
+	}
+	return err
+}
+
+// readyCheck is a named readiness condition, nil error means ready
+type readyCheck struct {
+	Name  string
+	Check func() error
+}
+
+// schemaChecks are the readiness conditions that query the db, the probe
+// runs them after each good ping
+func (mongo *mongoDB) schemaChecks() []readyCheck {
+	return []readyCheck{
+		{"indexes", func() error {
+			missing, err := mongo.missingIndexes()
+			if err != nil {
+				return err
+			}
+			if len(missing) > 0 {
+				names := []string{}
+				for _, idx := range missing {
+					names = append(names,
+						idx.Collection+"("+strings.Join(idx.Index.Key, ",")+")")
+				}
+				return errMissingIndexes(names)
+			}
+			return nil
+		}},
+		{"migrations", func() error {
+			pending, err := mongo.pending()
+			if err != nil {
+				return err
+			}
+			if len(pending) > 0 {
+				return errPendingMigrations(pending)
+			}
+			return nil
+		}},
+	}
+}
+
+// checkSchema runs schemaChecks and caches the results
+func (mongo *mongoDB) checkSchema() {
+	schema := map[string]error{}
+	for _, rc := range mongo.schemaChecks() {
+		schema[rc.Name] = rc.Check()
+	}
+	mongo.health.setSchema(schema)
+}
+
+// readyChecks read the cached results of the probe, nothing is ready
+// before its first ping
+func (mongo *mongoDB) readyChecks() []readyCheck {
+	checks := []readyCheck{{"db", func() error {
+		checked, err := mongo.health.get()
+		if err == nil && checked.IsZero() {
+			return errNotChecked
+		}
+		return err
+	}}}
+	for _, rc := range mongo.schemaChecks() {
+		name := rc.Name
+		checks = append(checks, readyCheck{name, func() error {
+			return mongo.health.getSchema(name)
+		}})
+	}
+	return checks
+}
+
+type errMissingIndexes []string
+
+func (e errMissingIndexes) Error() string {
+	return "missing indexes: " + strings.Join(e, ", ")
+}
+
+// getHealthz is the liveness probe, it does not touch the db
+func getHealthz() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"msg": "alive", "body": nil})
+	}
+}
+
+// getReadyz reports whether the service can serve the api, from what the
+// probe found last. Not ready is a 503 problem with a field error a check.
+func getReadyz(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		checks := gin.H{}
+		failed := []fieldError{}
+		for _, rc := range mongo.readyChecks() {
+			err := rc.Check()
+			if err != nil {
+				failed = append(failed, fieldError{Field: rc.Name, Rule: "ready", Message: err.Error()})
+				// what the probe found before the db went away is stale
+				if rc.Name == "db" {
+					break
+				}
+				continue
+			}
+			checks[rc.Name] = "ok"
+		}
+
+		if len(failed) > 0 {
+			ae := newAPIError(kindUnavailable, "not_ready", "service is not ready", nil)
+			ae.Fields = failed
+			abortError(c, ae)
+			return
+		}
+		if checked, _ := mongo.health.get(); !checked.IsZero() {
+			checks["db_checked_at"] = checked
+		}
+		c.JSON(http.StatusOK, gin.H{"msg": "ready", "body": checks})
+	}
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ========== ingest
+
+// ingester coalesces device fixes into the latest one per device and writes
+// them in the background. Requests only queue batches, when the writers fall
+// behind the queue fills up and requests are answered 503 ingest_busy.
+type ingester struct {
+	mongo  *mongoDB
+	conf   *ingestConfig
+	queue  chan []fix
+	writes chan []fix
+}
+
+var errIngestBusy = newAPIError(kindUnavailable, "ingest_busy",
+	"ingest queue is full, retry later", nil)
+
+func newIngester(mongo *mongoDB, conf *ingestConfig) *ingester {
+	return &ingester{
+		mongo:  mongo,
+		conf:   conf,
+		queue:  make(chan []fix, conf.QueueSize),
+		writes: make(chan []fix),
+	}
+}
+
+// submit queues fixes, waiting at most QueueWait for room
+func (ing *ingester) submit(ctx context.Context, fixes []fix) error {
+	select {
+	case ing.queue <- fixes:
+		return nil
+	default:
+	}
+
+	timer := time.NewTimer(ing.conf.QueueWait)
+	defer timer.Stop()
+	select {
+	case ing.queue <- fixes:
+		return nil
+	case <-timer.C:
+		metrics.ingestFixes.WithLabelValues("busy").Add(float64(len(fixes)))
+		return errIngestBusy
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run coalesces queued fixes until ctx is done, then drains the queue,
+// writes what is pending and waits for the writers
+func (ing *ingester) run(ctx context.Context) {
+	wg := &sync.WaitGroup{}
+	for i := 0; i < ing.conf.Writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range ing.writes {
+				ing.write(batch)
+			}
+		}()
+	}
+	defer func() {
+		close(ing.writes)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(ing.conf.FlushInterval)
+	defer ticker.Stop()
+	pending := map[string]fix{}
+	flush := func() {
+		metrics.ingestPending.Set(0)
+		if len(pending) == 0 {
+			return
+		}
+		batch := make([]fix, 0, len(pending))
+		for _, f := range pending {
+			batch = append(batch, f)
+		}
+		pending = map[string]fix{}
+		// blocks while every writer is busy, which is what fills the queue
+		ing.writes <- batch
+	}
+
+	for {
+		select {
+		case fixes := <-ing.queue:
+			coalesce(pending, fixes)
+			metrics.ingestPending.Set(float64(len(pending)))
+			if len(pending) >= ing.conf.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-ctx.Done():
+			// run is the only receiver, so this never blocks
+			for len(ing.queue) > 0 {
+				coalesce(pending, <-ing.queue)
+			}
+			flush()
+			return
+		}
+	}
+}
+
+func (ing *ingester) write(batch []fix) {
+	stale, err := ing.mongo.writeFixes(context.Background(), batch)
+	if err != nil {
+		metrics.ingestFixes.WithLabelValues("failed").Add(float64(len(batch)))
+		slog.Error("ingest write", "fixes", len(batch), "error", err.Error())
+		return
+	}
+	metrics.ingestFixes.WithLabelValues("stale").Add(float64(stale))
+	metrics.ingestFixes.WithLabelValues("written").Add(float64(len(batch) - stale))
+}
+
+// coalesce keeps the newest fix per device, older and repeated ones are
+// dropped so out of order reports never move a device back
+func coalesce(pending map[string]fix, fixes []fix) {
+	for _, f := range fixes {
+		p, ok := pending[f.Device]
+		switch {
+		case !ok:
+			pending[f.Device] = f
+		case p.TS < f.TS:
+			pending[f.Device] = f
+			metrics.ingestFixes.WithLabelValues("coalesced").Inc()
+		default:
+			metrics.ingestFixes.WithLabelValues("duplicate").Inc()
+		}
+	}
+}
+
+// dropFuture removes the fixes stamped after limit. Once written such a
+// fix would make every real one of its device stale until its time comes.
+func dropFuture(fixes []fix, limit time.Time) (kept []fix, dropped int) {
+	kept = fixes[:0]
+	for _, f := range fixes {
+		if f.TS > limit.UnixMilli() {
+			dropped++
+			continue
+		}
+		kept = append(kept, f)
+	}
+	return kept, dropped
+}
+
+func validFix(f *fix) bool {
+	return f.Device != "" && len(f.Device) <= 128 && f.TS > 0 &&
+		f.Lat >= -90 && f.Lat <= 90 && f.Lng >= -180 && f.Lng <= 180 &&
+		f.Accuracy >= 0 && f.Speed >= 0
+}
+
+// ========== ingest decoding
+
+// decodeFixesJSON reads one fix per line, bad lines are only counted
+func decodeFixesJSON(r io.Reader) (fixes []fix, rejected int, err error) {
+	reader := bufio.NewReaderSize(r, 64<<10)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, 0, err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var f fix
+			if json.Unmarshal(line, &f) == nil && validFix(&f) {
+				fixes = append(fixes, f)
+			} else {
+				rejected++
+			}
+		}
+		if err == io.EOF {
+			return fixes, rejected, nil
+		}
+	}
+}
+
+// decodeFixesProto reads a FixBatch as described in ingest.proto
+func decodeFixesProto(data []byte) (fixes []fix, rejected int, err error) {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, 0, protowire.ParseError(n)
+		}
+		data = data[n:]
+		if num != 1 || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return nil, 0, protowire.ParseError(n)
+			}
+			data = data[n:]
+			continue
+		}
+		msg, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return nil, 0, protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		f, err := decodeFixProto(msg)
+		if err != nil {
+			return nil, 0, err
+		}
+		if validFix(&f) {
+			fixes = append(fixes, f)
+		} else {
+			rejected++
+		}
+	}
+	return fixes, rejected, nil
+}
+
+func decodeFixProto(data []byte) (f fix, err error) {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return f, protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(data)
+			f.Device = string(v)
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(data)
+			f.TS = int64(v)
+		case num >= 3 && num <= 6 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(data)
+			switch num {
+			case 3:
+				f.Lat = math.Float64frombits(v)
+			case 4:
+				f.Lng = math.Float64frombits(v)
+			case 5:
+				f.Accuracy = math.Float64frombits(v)
+			case 6:
+				f.Speed = math.Float64frombits(v)
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return f, protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+	return f, nil
+}
+
+// ========== ingest handler
+
+func postIngest(ing *ingester) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		body := http.MaxBytesReader(c.Writer, c.Request.Body, ing.conf.MaxBodyBytes)
+
+		var fixes []fix
+		var rejected int
+		var err error
+		switch c.ContentType() {
+		case "application/x-ndjson", "application/jsonl", "application/json":
+			fixes, rejected, err = decodeFixesJSON(body)
+		case "application/x-protobuf", "application/protobuf":
+			var data []byte
+			data, err = io.ReadAll(body)
+			if err == nil {
+				fixes, rejected, err = decodeFixesProto(data)
+			}
+		default:
+			abortError(c, errValidation("unsupported_media_type", errors.New(
+				"content type must be application/x-ndjson or application/x-protobuf")))
+			return
+		}
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		var future int
+		fixes, future = dropFuture(fixes, time.Now().Add(ing.conf.MaxSkew))
+		rejected += future
+		metrics.ingestFixes.WithLabelValues("rejected").Add(float64(rejected))
+		if len(fixes) > 0 {
+			err = ing.submit(c.Request.Context(), fixes)
+			if err != nil {
+				abortError(c, err)
+				return
+			}
+		}
+		metrics.ingestFixes.WithLabelValues("accepted").Add(float64(len(fixes)))
+		c.JSON(http.StatusAccepted, gin.H{"msg": "ingest accepted",
+			"body": gin.H{"accepted": len(fixes), "rejected": rejected}})
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestIngestDecode(t *testing.T) {
+	lines := `{"device":"a","ts":1000,"lat":55.7,"lng":37.6,"speed":3}
+
+not json
+{"device":"b","ts":1000,"lat":91,"lng":0}
+{"device":"b","ts":2000,"lat":10,"lng":20,"accuracy":5}`
+	fixes, rejected, err := decodeFixesJSON(strings.NewReader(lines))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rejected)
+	assert.Equal(t, []fix{
+		{Device: "a", TS: 1000, Lat: 55.7, Lng: 37.6, Speed: 3},
+		{Device: "b", TS: 2000, Lat: 10, Lng: 20, Accuracy: 5},
+	}, fixes)
+
+	appendFix := func(b []byte, f fix) []byte {
+		var msg []byte
+		msg = protowire.AppendTag(msg, 1, protowire.BytesType)
+		msg = protowire.AppendString(msg, f.Device)
+		msg = protowire.AppendTag(msg, 2, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(f.TS))
+		for i, v := range []float64{f.Lat, f.Lng, f.Accuracy, f.Speed} {
+			msg = protowire.AppendTag(msg, protowire.Number(3+i), protowire.Fixed64Type)
+			msg = protowire.AppendFixed64(msg, math.Float64bits(v))
+		}
+		// unknown fields are skipped
+		msg = protowire.AppendTag(msg, 9, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, 1)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		return protowire.AppendBytes(b, msg)
+	}
+	var batch []byte
+	batch = appendFix(batch, fix{Device: "a", TS: 1000, Lat: 1, Lng: 2, Accuracy: 3, Speed: 4})
+	batch = appendFix(batch, fix{Device: "", TS: 1000})
+	fixes, rejected, err = decodeFixesProto(batch)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rejected)
+	assert.Equal(t, []fix{{Device: "a", TS: 1000, Lat: 1, Lng: 2, Accuracy: 3, Speed: 4}}, fixes)
+
+	_, _, err = decodeFixesProto(batch[:len(batch)-3])
+	assert.Error(t, err)
+}
+
+func TestIngestCoalesce(t *testing.T) {
+	pending := map[string]fix{}
+	coalesce(pending, []fix{
+		{Device: "a", TS: 2000, Lat: 2},
+		{Device: "a", TS: 1000, Lat: 1}, // out of order
+		{Device: "b", TS: 1000, Lat: 1},
+		{Device: "a", TS: 2000, Lat: 3}, // repeated
+	})
+	coalesce(pending, []fix{{Device: "b", TS: 3000, Lat: 3}})
+	assert.Equal(t, map[string]fix{
+		"a": {Device: "a", TS: 2000, Lat: 2},
+		"b": {Device: "b", TS: 3000, Lat: 3},
+	}, pending)
+}
+
+func TestIngestFuture(t *testing.T) {
+	now := time.Now()
+	fixes, dropped := dropFuture([]fix{
+		{Device: "a", TS: now.UnixMilli()},
+		{Device: "b", TS: now.Add(2 * time.Minute).UnixMilli()},
+		{Device: "c", TS: now.Add(-time.Hour).UnixMilli()},
+	}, now.Add(time.Minute))
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, []string{"a", "c"}, []string{fixes[0].Device, fixes[1].Device})
+}
+
+// BenchmarkIngest is the request side of ingest, decoding and queueing
+// batches of 1000 fixes while run coalesces them, without the db writes
+func BenchmarkIngest(b *testing.B) {
+	conf := defaultConfig().Ingest
+	ing := newIngester(&mongoDB{}, &conf)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		pending := map[string]fix{}
+		for {
+			select {
+			case fixes := <-ing.queue:
+				coalesce(pending, fixes)
+				if len(pending) >= conf.BatchSize {
+					pending = map[string]fix{}
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	gin.SetMode(gin.ReleaseMode)
+	r := gin.New()
+	r.POST("/ingest", postIngest(ing))
+	body := &bytes.Buffer{}
+	ts := time.Now().UnixMilli()
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(body, `{"device":"dev-%d","ts":%d,"lat":55.7,"lng":37.6,"speed":3}`+"\n", i%300, ts+int64(i))
+	}
+	data := body.Bytes()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		req := httptest.NewRequest("POST", "/ingest", bytes.NewReader(data))
+		req.Header.Set("Content-Type", "application/x-ndjson")
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+		if res.Code != http.StatusAccepted {
+			b.Fatal("ingest: ", res.Code, res.Body.String())
+		}
+	}
+	b.ReportMetric(float64(b.N*1000)/b.Elapsed().Seconds(), "fixes/s")
+}
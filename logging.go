@@ -0,0 +1,83 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== logging
+
+const requestIDHeader = "X-Request-ID"
+
+// newLogger writes json lines to stdout, level is one of logLevels
+func newLogger(level string) *slog.Logger {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		lvl = slog.LevelInfo
+	}
+	return slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: lvl}))
+}
+
+// requestLogger returns the default logger tagged with the request ids
+func requestLogger(c *gin.Context) *slog.Logger {
+	logger := slog.Default().With("request_id", c.GetString("request_id"))
+	if uid := c.GetString("user_id"); uid != "" {
+		logger = logger.With("user_id", uid)
+	}
+	if kid := c.GetString("key_id"); kid != "" {
+		logger = logger.With("key_id", kid)
+	}
+	return logger
+}
+
+// middlewareLogger tags the request with an id and logs it once it is served
+func middlewareLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		id := c.GetHeader(requestIDHeader)
+		if id == "" || len(id) > 64 {
+			id = bson.NewObjectId().Hex()
+		}
+		c.Set("request_id", id)
+		c.Header(requestIDHeader, id)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		attrs := []slog.Attr{
+			slog.String("request_id", id),
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+			slog.Int("bytes", c.Writer.Size()),
+		}
+		if uid := c.GetString("user_id"); uid != "" {
+			attrs = append(attrs, slog.String("user_id", uid))
+		}
+		if kid := c.GetString("key_id"); kid != "" {
+			attrs = append(attrs, slog.String("key_id", kid))
+		}
+		if sc := trace.SpanContextFromContext(c.Request.Context()); sc.IsValid() {
+			attrs = append(attrs, slog.String("trace_id", sc.TraceID().String()))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.String()))
+		}
+
+		level := slog.LevelInfo
+		if status >= 500 {
+			level = slog.LevelError
+		} else if status >= 400 {
+			level = slog.LevelWarn
+		}
+		slog.LogAttrs(c.Request.Context(), level, "request", attrs...)
+	}
+}
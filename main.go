@@ -1,41 +1,107 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
+	"log/slog"
 	"os"
 )
 
 type flags struct {
-	start *string
+	start    *string
+	config   *string
+	listen   *string
+	mongoURI *string
+	static   *string
+	logLevel *string
+	dryRun   *bool
+	force    *bool
 }
 
 func main() {
 	// processing console arguments
 	fs := flags{}
-	fs.start = flag.String("start", "geoloc", "start geoloc service")
+	fs.start = flag.String("start", "geoloc", "what to run: geoloc, migrate, reset or keys")
+	fs.config = flag.String("config", "", "path to a yaml or toml config file")
+	fs.listen = flag.String("listen", "", "listen address, e.g. :8081")
+	fs.mongoURI = flag.String("mongo-uri", "", "mongodb connection uri")
+	fs.static = flag.String("static", "", "static files folder")
+	fs.logLevel = flag.String("log-level", "", "log level: debug, info, warn, error")
+	fs.dryRun = flag.Bool("dry-run", false, "migrate: list the pending migrations without applying them")
+	fs.force = flag.Bool("force", false, "reset: confirm dropping all data")
 	flag.Parse()
 
+	conf, err := fs.loadConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	slog.SetDefault(newLogger(conf.LogLevel))
+
 	switch *fs.start {
 	case "geoloc":
 		m := mongoDB{}
-		m.setDefault()
+		err = m.setConfig(&conf.Mongo)
+		if err != nil {
+			log.Fatal(err)
+		}
 
-		router(&m).Run(":" + os.Getenv("PORT"))
-	case "init":
-		err := initDB()
+		shutdownTracing, err := setTracing(&conf.Tracing)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = serve(conf, &m)
+		if terr := shutdownTracing(context.Background()); terr != nil {
+			slog.Error("flush traces", "error", terr)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	case "migrate":
+		err = migrateDB(conf, os.Stdout, *fs.dryRun)
+		if err != nil {
+			log.Fatal(err)
+		}
+	case "reset":
+		err = resetDB(conf, os.Stdout, *fs.force)
 		if err != nil {
 			log.Fatal(err)
 		} else {
-			log.Println("init db successful complete")
+			log.Println("reset db successful complete")
+		}
+	case "init":
+		log.Fatal("-start init is gone: -start migrate adds what the db lacks, -start reset -force wipes it")
+	case "keys":
+		err = keysCommand(conf, flag.Args(), os.Stdout)
+		if err != nil {
+			log.Fatal(err)
 		}
+	default:
+		log.Fatalf("unknown -start %q", *fs.start)
 	}
 }
 
-func initDB() (err error) {
-	mongo := mongoDB{}
-	mongo.setDefault()
-	defer mongo.Session.Close()
-	err = mongo.init()
-	return err
+// loadConfig applies flags on top of the file and env config
+func (fs *flags) loadConfig() (conf *config, err error) {
+	conf, err = loadConfig(*fs.config)
+	if err != nil {
+		return nil, err
+	}
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "listen":
+			conf.Listen = *fs.listen
+		case "mongo-uri":
+			conf.Mongo.URI = *fs.mongoURI
+		case "static":
+			conf.StaticDir = *fs.static
+		case "log-level":
+			conf.LogLevel = *fs.logLevel
+		}
+	})
+
+	err = conf.validate()
+	return conf, err
 }
@@ -3,10 +3,13 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"github.com/gin-gonic/gin"
+	mgo "gopkg.in/mgo.v2"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -16,6 +19,11 @@ func setTestEnv() {
 	os.Setenv("PORT", "8000")
 }
 
+// newTestRouter is router with a keyring and limiter of its own
+func newTestRouter(conf *config, db *mongoDB) *gin.Engine {
+	return router(conf, db, newIngester(db, &conf.Ingest), newKeyring(db, &conf.Auth), newLimitStore(&conf.RateLimit))
+}
+
 func postReq(eng *gin.Engine, url string, buf *bytes.Buffer) []byte {
 	post, _ := http.NewRequest("POST", url, buf)
 	post.Header.Set("X-Custom-Header", "myvalue")
@@ -31,7 +39,11 @@ func TestRouter(t *testing.T) {
 		t.Error("db err: ", err)
 	}
 	setTestEnv()
-	testRouter := router(db)
+	conf, err := loadConfig("")
+	if err != nil {
+		t.Fatal("config err: ", err)
+	}
+	testRouter := newTestRouter(conf, db)
 
 	// post/get points
 	{
@@ -106,3 +118,261 @@ func TestRouter(t *testing.T) {
 		wg.Wait()
 	}
 }
+
+func TestHealth(t *testing.T) {
+	conf := defaultConfig()
+	db := &mongoDB{}
+	testRouter := newTestRouter(conf, db)
+
+	// liveness doesn't need the db
+	{
+		req, _ := http.NewRequest("GET", "/healthz", nil)
+		response := httptest.NewRecorder()
+		testRouter.ServeHTTP(response, req)
+		if response.Code != http.StatusOK {
+			t.Error("error, healthz status: ", response.Code)
+		}
+	}
+	// api answers 503 problem+json while the db is down
+	{
+		db.health.set(errors.New("no reachable servers"))
+		req, _ := http.NewRequest("GET", "/api/v1/locs/all", nil)
+		response := httptest.NewRecorder()
+		testRouter.ServeHTTP(response, req)
+		if response.Code != http.StatusServiceUnavailable {
+			t.Error("error, api status with db down: ", response.Code)
+		}
+		res := problem{}
+		err := json.Unmarshal(response.Body.Bytes(), &res)
+		if err != nil || res.Code != "db_unavailable" {
+			t.Error("error, 503 body is not a problem: ", err, res)
+		}
+		if response.Header().Get("Content-Type") != problemContentType {
+			t.Error("error, 503 content type: ", response.Header().Get("Content-Type"))
+		}
+	}
+	// readiness reads what the probe found, a failed check is a field
+	{
+		readyz := func() (int, problem) {
+			req, _ := http.NewRequest("GET", "/readyz", nil)
+			response := httptest.NewRecorder()
+			testRouter.ServeHTTP(response, req)
+			res := problem{}
+			json.Unmarshal(response.Body.Bytes(), &res)
+			return response.Code, res
+		}
+		db.health = dbHealth{}
+		code, res := readyz()
+		if code != http.StatusServiceUnavailable || res.Code != "not_ready" ||
+			len(res.Errors) != 1 || res.Errors[0].Field != "db" {
+			t.Error("error, readyz before the first probe: ", code, res)
+		}
+		db.health.set(nil)
+		code, res = readyz()
+		if code != http.StatusServiceUnavailable || res.Code != "not_ready" || len(res.Errors) != 2 {
+			t.Error("error, readyz before the schema is checked: ", code, res)
+		}
+		db.health.setSchema(map[string]error{"indexes": nil,
+			"migrations": errPendingMigrations{{Version: 2, Name: "external_ids"}}})
+		code, res = readyz()
+		if code != http.StatusServiceUnavailable || len(res.Errors) != 1 || res.Errors[0].Field != "migrations" {
+			t.Error("error, readyz with pending migrations: ", code, res)
+		}
+		db.health.setSchema(map[string]error{"indexes": nil, "migrations": nil})
+		if code, _ = readyz(); code != http.StatusOK {
+			t.Error("error, readyz status: ", code)
+		}
+	}
+}
+
+func TestProblem(t *testing.T) {
+	conf := defaultConfig()
+	testRouter := newTestRouter(conf, &mongoDB{})
+
+	// unknown api route
+	{
+		req, _ := http.NewRequest("GET", "/api/v1/nothing", nil)
+		response := httptest.NewRecorder()
+		testRouter.ServeHTTP(response, req)
+		res := problem{}
+		err := json.Unmarshal(response.Body.Bytes(), &res)
+		if err != nil || response.Code != http.StatusNotFound ||
+			res.Status != http.StatusNotFound || res.Code != "route_not_found" {
+			t.Error("error, unknown route problem: ", response.Code, err, res)
+		}
+	}
+	// malformed path id
+	{
+		req, _ := http.NewRequest("GET", "/api/v1/users/not-an-id", nil)
+		response := httptest.NewRecorder()
+		testRouter.ServeHTTP(response, req)
+		res := problem{}
+		json.Unmarshal(response.Body.Bytes(), &res)
+		if response.Code != http.StatusBadRequest || res.Code != "invalid_id" {
+			t.Error("error, malformed id problem: ", response.Code, res)
+		}
+	}
+	// error classification
+	{
+		cases := []struct {
+			err    error
+			status int
+		}{
+			{mgo.ErrNotFound, http.StatusNotFound},
+			{&mgo.LastError{Code: 11000}, http.StatusConflict},
+			{errors.New("no reachable servers"), http.StatusServiceUnavailable},
+			{errValidation("invalid_request", errors.New("bad")), http.StatusBadRequest},
+			{errors.New("boom"), http.StatusInternalServerError},
+		}
+		for _, cs := range cases {
+			if status := toAPIError(cs.err).Status(); status != cs.status {
+				t.Errorf("error, %v maps to %d, want %d", cs.err, status, cs.status)
+			}
+		}
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	conf := defaultConfig()
+	testRouter := newTestRouter(conf, &mongoDB{})
+
+	req, _ := http.NewRequest("GET", "/healthz", nil)
+	testRouter.ServeHTTP(httptest.NewRecorder(), req)
+
+	req, _ = http.NewRequest("GET", "/metrics", nil)
+	response := httptest.NewRecorder()
+	testRouter.ServeHTTP(response, req)
+	if response.Code != http.StatusOK {
+		t.Error("error, metrics status: ", response.Code)
+	}
+	if !strings.Contains(response.Body.String(),
+		`geoloc_http_requests_total{method="GET",route="/healthz",status="200"}`) {
+		t.Error("error, no request count for /healthz")
+	}
+}
+
+func TestValidation(t *testing.T) {
+	conf := defaultConfig()
+	conf.StrictJSON = true
+	conf.Server.BulkMaxItems = 2
+	testRouter := newTestRouter(conf, &mongoDB{})
+	defer setupValidation(false)
+
+	cases := []struct {
+		url    string
+		body   string
+		fields []string
+	}{
+		{"/api/v1/locs",
+			`{"tobject":"Car","location":{"type":"Circle","coordinates":[200,10]}}`,
+			[]string{"tobject", "location.type", "location.coordinates"}},
+		{"/api/v1/users", `{"Email":"not-an-email"}`, []string{"email"}},
+		{"/api/v1/locs", `{"tobject":"User","color":"red"}`, []string{"color"}},
+		{"/api/v1/locs/bulk", `{"items":[]}`, []string{"items"}},
+		{"/api/v1/events/bulk", `{"items":[{},{},{}]}`, []string{"items"}},
+	}
+	for _, cs := range cases {
+		post, _ := http.NewRequest("POST", cs.url, bytes.NewBufferString(cs.body))
+		post.Header.Set("Content-Type", "application/json")
+		response := httptest.NewRecorder()
+		testRouter.ServeHTTP(response, post)
+		if response.Code != http.StatusBadRequest {
+			t.Error("error, status for invalid body: ", cs.body, response.Code)
+			continue
+		}
+
+		res := problem{}
+		json.Unmarshal(response.Body.Bytes(), &res)
+		got := []string{}
+		for _, fe := range res.Errors {
+			got = append(got, fe.Field)
+		}
+		if strings.Join(got, ",") != strings.Join(cs.fields, ",") {
+			t.Errorf("error, field errors for %s: %v, want %v", cs.body, got, cs.fields)
+		}
+	}
+}
+
+func TestETag(t *testing.T) {
+	// If-None-Match uses weak comparison
+	{
+		etag := versionETag(3)
+		for header, want := range map[string]bool{
+			`"3"`: true, `W/"3"`: true, `"1", "3"`: true, `*`: true, `"4"`: false, ``: false,
+		} {
+			if etagMatches(header, etag) != want {
+				t.Errorf("error, etagMatches(%q, %q) != %v", header, etag, want)
+			}
+		}
+	}
+	// If-Match sets the expected version
+	{
+		for header, want := range map[string]int64{`"7"`: 7, ``: 2, `*`: 2} {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request, _ = http.NewRequest("PUT", "/", nil)
+			c.Request.Header.Set("If-Match", header)
+			version := int64(2)
+			err := applyIfMatch(c, &version)
+			if err != nil || version != want {
+				t.Errorf("error, If-Match %q gives %d, %v", header, version, err)
+			}
+		}
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request, _ = http.NewRequest("PUT", "/", nil)
+		c.Request.Header.Set("If-Match", `W/"7"`)
+		version := int64(0)
+		if applyIfMatch(c, &version) != errVersionMismatch {
+			t.Error("error, weak If-Match should not match")
+		}
+	}
+	// bodies built for the viewer, and their 304s, stay out of shared caches
+	{
+		for name, answer := range map[string]func(c *gin.Context){
+			"versioned": func(c *gin.Context) { jsonVersioned(c, 3, "get", nil) },
+			"cached":    func(c *gin.Context) { jsonCached(c, "get", nil) },
+		} {
+			for _, inm := range []string{"", "*"} {
+				response := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(response)
+				c.Request, _ = http.NewRequest("GET", "/", nil)
+				c.Request.Header.Set("If-None-Match", inm)
+				c.Header("Vary", "Origin")
+				answer(c)
+				vary := strings.Join(response.Header().Values("Vary"), ", ")
+				if response.Header().Get("Cache-Control") != "private" ||
+					vary != "Origin, Authorization, Cookie, X-API-Key" {
+					t.Errorf("error, %s %q cache headers: %v", name, inm, response.Header())
+				}
+			}
+		}
+	}
+}
+
+func TestLegacyMembers(t *testing.T) {
+	conf := defaultConfig()
+	conf.StrictJSON = true
+	testRouter := newTestRouter(conf, &mongoDB{})
+	defer setupValidation(false)
+
+	// the collection routes still read the old member names, an unknown ID
+	// would fail before the email is checked
+	for _, method := range []string{"PUT", "POST"} {
+		req, _ := http.NewRequest(method, "/api/v1/users",
+			bytes.NewBufferString(`{"ID":"5a1f5e3c0000000000000000","Email":"not-an-email"}`))
+		req.Header.Set("Content-Type", "application/json")
+		response := httptest.NewRecorder()
+		testRouter.ServeHTTP(response, req)
+
+		res := problem{}
+		json.Unmarshal(response.Body.Bytes(), &res)
+		if response.Code != http.StatusBadRequest ||
+			len(res.Errors) != 1 || res.Errors[0].Field != "email" {
+			t.Error("error, legacy field errors: ", method, response.Code, res.Errors)
+		}
+	}
+
+	body, _ := json.Marshal(legacyEvent{Name: "fair"})
+	if !strings.Contains(string(body), `"Name":"fair"`) {
+		t.Error("error, legacy event members: ", string(body))
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// ========== metrics
+
+type metricSet struct {
+	registry      *prometheus.Registry
+	httpRequests  *prometheus.CounterVec
+	httpDuration  *prometheus.HistogramVec
+	dbDuration    *prometheus.HistogramVec
+	dbErrors      *prometheus.CounterVec
+	sessionClones prometheus.Counter
+	ingestFixes   *prometheus.CounterVec
+	ingestPending prometheus.Gauge
+	rateLimited   *prometheus.CounterVec
+	auditFailures prometheus.Counter
+	notifications *prometheus.CounterVec
+	webhooks      *prometheus.CounterVec
+	changes       *prometheus.CounterVec
+}
+
+var metrics = newMetricSet()
+
+func newMetricSet() *metricSet {
+	m := &metricSet{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "geoloc",
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		dbDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "geoloc",
+			Name:      "db_operation_duration_seconds",
+			Help:      "Mongo operation latency by mongoDB method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"op"}),
+		dbErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "db_operation_errors_total",
+			Help:      "Failed mongo operations by mongoDB method.",
+		}, []string{"op"}),
+		sessionClones: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "db_session_clones_total",
+			Help:      "Mongo sessions cloned from the main session.",
+		}),
+		ingestFixes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "ingest_fixes_total",
+			Help:      "Ingested device fixes by result.",
+		}, []string{"result"}),
+		ingestPending: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "geoloc",
+			Name:      "ingest_pending_devices",
+			Help:      "Devices with a coalesced fix waiting for a write.",
+		}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "rate_limited_total",
+			Help:      "Requests answered 429 by budget class.",
+		}, []string{"class"}),
+		auditFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "audit_write_failures_total",
+			Help:      "Writes whose audit log entry could not be stored.",
+		}),
+		notifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "notifications_total",
+			Help:      "Notifications by channel and result: queued, sent, retried, failed or canceled.",
+		}, []string{"channel", "result"}),
+		webhooks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "webhook_deliveries_total",
+			Help:      "Webhook deliveries by result: queued, delivered, retried, dead or replayed.",
+		}, []string{"result"}),
+		changes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "geoloc",
+			Name:      "bus_changes_total",
+			Help:      "Changes the bus handed to an async subscriber by result: queued or dropped when it is behind, a dropped one is lost to it.",
+		}, []string{"subscriber", "result"}),
+	}
+	m.registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		m.httpRequests, m.httpDuration,
+		m.dbDuration, m.dbErrors, m.sessionClones,
+		m.ingestFixes, m.ingestPending, m.rateLimited,
+		m.auditFailures, m.notifications, m.webhooks, m.changes,
+	)
+	return m
+}
+
+// observeDB records a db method timing, called by traceDB
+func observeDB(op string, start time.Time, err *error) {
+	metrics.dbDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	if err != nil && *err != nil {
+		metrics.dbErrors.WithLabelValues(op).Inc()
+	}
+}
+
+func middlewareMetrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		method := c.Request.Method
+		metrics.httpRequests.WithLabelValues(
+			method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		metrics.httpDuration.WithLabelValues(
+			method, route).Observe(time.Since(start).Seconds())
+	}
+}
+
+// getMetrics serves the process wide metrics and the db gauges of this mongo
+func getMetrics(mongo *mongoDB) gin.HandlerFunc {
+	dbRegistry := prometheus.NewRegistry()
+	dbRegistry.MustRegister(&dbCollector{mongo: mongo})
+
+	handler := promhttp.HandlerFor(
+		prometheus.Gatherers{metrics.registry, dbRegistry},
+		promhttp.HandlerOpts{},
+	)
+	return gin.WrapH(handler)
+}
+
+// ========== db collector
+
+var collectionDocs = prometheus.NewDesc(
+	"geoloc_db_collection_documents",
+	"Documents per collection, counted on scrape.",
+	[]string{"collection"}, nil,
+)
+
+// dbCollector counts documents on scrape while the db probe is healthy
+type dbCollector struct {
+	mongo *mongoDB
+}
+
+func (dc *dbCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collectionDocs
+}
+
+func (dc *dbCollector) Collect(ch chan<- prometheus.Metric) {
+	if dc.mongo.Session == nil {
+		return
+	}
+	if _, err := dc.mongo.health.get(); err != nil {
+		return
+	}
+
+	session := dc.mongo.clone()
+	defer session.Close()
+	for _, name := range []string{"dviUsers", "dviEvents", "dviLocations"} {
+		count, err := session.DB(dc.mongo.Database).C(name).Count()
+		if err != nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(
+			collectionDocs, prometheus.GaugeValue, float64(count), name)
+	}
+}
@@ -1,16 +1,19 @@
 package main
 
 import (
+	"strings"
+
 	"github.com/gin-gonic/gin"
 )
 
 // ========== middlewares
 
+// middlewareDB rejects requests while the background probe sees the db down
 func middlewareDB(mongo *mongoDB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		err := mongo.Session.Ping()
+		_, err := mongo.health.get()
 		if err != nil {
-			c.Abort()
+			abortError(c, errUnavailable("db_unavailable", err))
 		} else {
 			c.Set("mongo", mongo)
 			c.Next()
@@ -18,26 +21,14 @@ func middlewareDB(mongo *mongoDB) gin.HandlerFunc {
 	}
 }
 
-func middlewareCORS() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		c.Writer.Header().Set("Content-Type", "application/json")
-		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-		c.Writer.Header().Set("Access-Control-Max-Age", "86400")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(200)
-		} else {
-			c.Next()
-		}
-	}
-}
-
-func returnPublic() gin.HandlerFunc {
+func returnPublic(staticDir string) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		method := context.Request.Method
-		if method == "GET" {
-			context.File(STATIC_FOLDER)
+		if strings.HasPrefix(context.Request.URL.Path, "/api/") {
+			abortError(context, errNotFound("route_not_found",
+				method+" "+context.Request.URL.Path+" is not an api route"))
+		} else if method == "GET" {
+			context.File(staticDir)
 		} else {
 			context.Next()
 		}
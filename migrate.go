@@ -0,0 +1,313 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== migrations
+
+// migration is a versioned, forward only change to the db. Up runs against
+// a db that holds data and must be safe to run again, a migrate that fails
+// before recording it does.
+type migration struct {
+	Version int
+	Name    string
+	Up      func(db *mgo.Database) error
+	// Indexes are the ones an index migration creates
+	Indexes []dbIndex
+}
+
+// migrationRecord is an applied migration in schema_migrations
+type migrationRecord struct {
+	Version   int           `bson:"_id" json:"version"`
+	Name      string        `bson:"name" json:"name"`
+	AppliedAt time.Time     `bson:"applied_at" json:"applied_at"`
+	Took      time.Duration `bson:"took" json:"took"`
+}
+
+// migrations are every migration in version order, a new one is appended
+// with the next version and never changed once released. An index is
+// added by a new migration and to indexes(), never to a released one.
+func (mongo *mongoDB) migrations() []migration {
+	return []migration{
+		indexMigration(1, "indexes",
+			dbIndex{"dviUsers", mgo.Index{
+				Key:        []string{"name", "email", "text", "events"},
+				Background: true,
+				Sparse:     true,
+			}},
+			dbIndex{"dviEvents", mgo.Index{
+				Key:        []string{"name", "text", "users", "timestamp"},
+				Background: true,
+				Sparse:     true,
+			}},
+			dbIndex{"dviEvents", mgo.Index{Key: []string{"ttl"}, ExpireAfter: mongo.EventExpire}},
+			dbIndex{"dviLocations", mgo.Index{Key: []string{"$2dsphere:location"}, Bits: 26}},
+		),
+		externalIDMigration(2, "external_ids", "dviEvents", "dviLocations"),
+		indexMigration(3, "api_keys",
+			dbIndex{"dviKeys", mgo.Index{Key: []string{"prefix"}, Unique: true}},
+		),
+		indexMigration(4, "reports",
+			dbIndex{"dviReports", mgo.Index{Key: []string{"target", "resolved_at"}}},
+			dbIndex{"dviReports", mgo.Index{Key: []string{"kind", "created_at"}}},
+		),
+		indexMigration(5, "audit",
+			dbIndex{"dviAudit", mgo.Index{Key: []string{"at"}, ExpireAfter: mongo.AuditRetention}},
+			dbIndex{"dviAudit", mgo.Index{Key: []string{"kind", "entity", "-at"}}},
+			dbIndex{"dviAudit", mgo.Index{Key: []string{"actor", "-at"}}},
+		),
+		indexMigration(6, "relations",
+			dbIndex{"dviRelations", mgo.Index{Key: []string{"kind", "from", "to"}, Unique: true}},
+			dbIndex{"dviRelations", mgo.Index{Key: []string{"kind", "to", "status"}}},
+		),
+		indexMigration(7, "notifications",
+			dbIndex{"dviNotifications", mgo.Index{Key: []string{"key"}, Unique: true}},
+			dbIndex{"dviNotifications", mgo.Index{Key: []string{"status", "next_at"}}},
+			dbIndex{"dviNotifications", mgo.Index{Key: []string{"user", "-created_at"}}},
+			dbIndex{"dviNotifications", mgo.Index{Key: []string{"created_at"}, ExpireAfter: mongo.NotifyRetention}},
+		),
+		indexMigration(8, "webhooks",
+			dbIndex{"dviDeliveries", mgo.Index{Key: []string{"hook", "event"}, Unique: true}},
+			dbIndex{"dviDeliveries", mgo.Index{Key: []string{"status", "next_at"}}},
+			dbIndex{"dviDeliveries", mgo.Index{Key: []string{"hook", "status", "-created_at"}}},
+			dbIndex{"dviDeliveries", mgo.Index{Key: []string{"delivered_at"}, ExpireAfter: mongo.HookRetention}},
+		),
+		friendPairMigration(9, "friend_pairs"),
+	}
+}
+
+// friendPairMigration sets the pair of the friend relations and makes it
+// unique. Two relations between the same users are requests they sent each
+// other, they become one friendship: the older relation is kept accepted.
+func friendPairMigration(version int, name string) migration {
+	m := indexMigration(version, name,
+		dbIndex{"dviRelations", mgo.Index{Key: []string{"pair"}, Unique: true, Sparse: true}},
+	)
+	ensure := m.Up
+	m.Up = func(db *mgo.Database) error {
+		c := db.C("dviRelations")
+		iter := c.Find(bson.M{"kind": "friend", "pair": bson.M{"$exists": false}}).
+			Sort("created_at").Iter()
+		var rel relation
+		for iter.Next(&rel) {
+			pair := friendPair(rel.From, rel.To)
+			var twin relation
+			err := c.Find(bson.M{"pair": pair}).One(&twin)
+			switch {
+			case err == mgo.ErrNotFound:
+				err = c.UpdateId(rel.ID, bson.M{"$set": bson.M{"pair": pair}})
+			case err == nil:
+				if twin.Status != "accepted" {
+					err = c.UpdateId(twin.ID, bson.M{"$set": bson.M{
+						"status": "accepted", "accepted_at": time.Now()}})
+				}
+				if err == nil {
+					err = c.RemoveId(rel.ID)
+				}
+			}
+			if err != nil {
+				iter.Close()
+				return err
+			}
+		}
+		if err := iter.Close(); err != nil && !isNsNotFound(err) {
+			return err
+		}
+		return ensure(db)
+	}
+	return m
+}
+
+// externalIDMigration adds the unique external_id indexes of colls. A db
+// that already has an external id twice is left as it is and the migration
+// fails naming them, to be made distinct before it runs again.
+func externalIDMigration(version int, name string, colls ...string) migration {
+	idxs := []dbIndex{}
+	for _, coll := range colls {
+		idxs = append(idxs, dbIndex{coll, mgo.Index{Key: []string{"external_id"}, Unique: true, Sparse: true}})
+	}
+	m := indexMigration(version, name, idxs...)
+	ensure := m.Up
+	m.Up = func(db *mgo.Database) error {
+		for _, coll := range colls {
+			var dups []struct {
+				ID string `bson:"_id"`
+				N  int    `bson:"n"`
+			}
+			err := db.C(coll).Pipe([]bson.M{
+				{"$match": bson.M{"external_id": bson.M{"$exists": true}}},
+				{"$group": bson.M{"_id": "$external_id", "n": bson.M{"$sum": 1}}},
+				{"$match": bson.M{"n": bson.M{"$gt": 1}}},
+				{"$limit": 10},
+			}).All(&dups)
+			if err != nil && !isNsNotFound(err) {
+				return err
+			}
+			if len(dups) > 0 {
+				ids := []string{}
+				for _, d := range dups {
+					ids = append(ids, fmt.Sprintf("%q (%d)", d.ID, d.N))
+				}
+				return fmt.Errorf("%s has external ids used more than once: %s", coll, strings.Join(ids, ", "))
+			}
+		}
+		return ensure(db)
+	}
+	return m
+}
+
+// indexMigration creates idxs, a ttl one with the expiry of the config
+func indexMigration(version int, name string, idxs ...dbIndex) migration {
+	return migration{Version: version, Name: name, Indexes: idxs, Up: func(db *mgo.Database) error {
+		for _, idx := range idxs {
+			err := ensureIndex(db, idx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}}
+}
+
+// pendingMigrations returns the migrations of all that are not applied, in
+// order. A version applied under another name, or unknown to all, is an
+// error: the db is ahead of or diverged from this build.
+func pendingMigrations(all []migration, applied []migrationRecord) (pending []migration, err error) {
+	done := map[int]string{}
+	for _, r := range applied {
+		done[r.Version] = r.Name
+	}
+	last := 0
+	for _, m := range all {
+		if m.Version <= last {
+			return nil, fmt.Errorf("migration %d %s: versions must increase", m.Version, m.Name)
+		}
+		last = m.Version
+		name, ok := done[m.Version]
+		if !ok {
+			pending = append(pending, m)
+			continue
+		}
+		if name != m.Name {
+			return nil, fmt.Errorf("migration %d: applied as %s, this build has %s", m.Version, name, m.Name)
+		}
+		delete(done, m.Version)
+	}
+	for v, name := range done {
+		return nil, fmt.Errorf("migration %d %s: applied but unknown to this build", v, name)
+	}
+	return pending, nil
+}
+
+// appliedMigrations reads schema_migrations in version order
+func (mongo *mongoDB) appliedMigrations() (applied []migrationRecord, err error) {
+	session := mongo.clone()
+	defer session.Close()
+	err = session.DB(mongo.Database).C("schema_migrations").Find(nil).Sort("_id").All(&applied)
+	return applied, err
+}
+
+// pending returns the migrations the db is missing
+func (mongo *mongoDB) pending() ([]migration, error) {
+	applied, err := mongo.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+	return pendingMigrations(mongo.migrations(), applied)
+}
+
+// migrate applies the pending migrations in order and records each one,
+// with dryRun it only lists them. The ttl indexes follow the retention of
+// the config on every run, dryRun leaves them too.
+func (mongo *mongoDB) migrate(out io.Writer, dryRun bool) (err error) {
+	pending, err := mongo.pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		fmt.Fprintln(out, "schema is current")
+	}
+
+	session := mongo.clone()
+	defer session.Close()
+	session.EnsureSafe(&mgo.Safe{})
+	db := session.DB(mongo.Database)
+	for _, m := range pending {
+		if dryRun {
+			fmt.Fprintf(out, "pending %d %s\n", m.Version, m.Name)
+			continue
+		}
+		start := time.Now()
+		err = m.Up(db)
+		if err != nil {
+			return fmt.Errorf("migration %d %s: %v", m.Version, m.Name, err)
+		}
+		rec := migrationRecord{Version: m.Version, Name: m.Name, AppliedAt: time.Now(), Took: time.Since(start)}
+		err = db.C("schema_migrations").Insert(rec)
+		// another instance applied it meanwhile, Up is safe to run twice
+		if err != nil && !mgo.IsDup(err) {
+			return fmt.Errorf("migration %d %s: record: %v", m.Version, m.Name, err)
+		}
+		fmt.Fprintf(out, "applied %d %s in %s\n", m.Version, m.Name, rec.Took.Round(time.Millisecond))
+	}
+	if dryRun {
+		return nil
+	}
+
+	for _, idx := range mongo.indexes() {
+		if idx.Index.ExpireAfter == 0 {
+			continue
+		}
+		err = ensureIndex(db, idx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type errPendingMigrations []migration
+
+func (e errPendingMigrations) Error() string {
+	names := []string{}
+	for _, m := range e {
+		names = append(names, strconv.Itoa(m.Version)+" "+m.Name)
+	}
+	return "pending migrations: " + strings.Join(names, ", ")
+}
+
+// ========== migrate cli
+
+// migrateDB applies the pending migrations of the db of conf
+func migrateDB(conf *config, out io.Writer, dryRun bool) (err error) {
+	mongo := mongoDB{}
+	err = mongo.setConfig(&conf.Mongo)
+	if err != nil {
+		return err
+	}
+	defer mongo.Session.Close()
+	return mongo.migrate(out, dryRun)
+}
+
+// resetDB wipes the db of conf and migrates it from scratch, only with force
+func resetDB(conf *config, out io.Writer, force bool) (err error) {
+	if !force {
+		return errors.New("reset drops every user, event and location: run it with -force")
+	}
+	mongo := mongoDB{}
+	err = mongo.setConfig(&conf.Mongo)
+	if err != nil {
+		return err
+	}
+	defer mongo.Session.Close()
+	return mongo.reset(out)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigratePending(t *testing.T) {
+	all := []migration{{Version: 1, Name: "indexes"}, {Version: 2, Name: "backfill"}, {Version: 3, Name: "drop_old"}}
+
+	pending, err := pendingMigrations(all, nil)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 3)
+
+	pending, err = pendingMigrations(all, []migrationRecord{{Version: 1, Name: "indexes"}, {Version: 3, Name: "drop_old"}})
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, 2, pending[0].Version)
+	assert.Equal(t, "pending migrations: 2 backfill", errPendingMigrations(pending).Error())
+
+	pending, err = pendingMigrations(all, []migrationRecord{{Version: 1, Name: "indexes"},
+		{Version: 2, Name: "backfill"}, {Version: 3, Name: "drop_old"}})
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+
+	for _, c := range []struct {
+		all     []migration
+		applied []migrationRecord
+		want    string
+	}{
+		{all, []migrationRecord{{Version: 1, Name: "other"}}, "applied as other"},
+		{all, []migrationRecord{{Version: 4, Name: "future"}}, "unknown to this build"},
+		{[]migration{{Version: 2, Name: "b"}, {Version: 1, Name: "a"}}, nil, "must increase"},
+		{[]migration{{Version: 1, Name: "a"}, {Version: 1, Name: "b"}}, nil, "must increase"},
+	} {
+		_, err = pendingMigrations(c.all, c.applied)
+		if assert.Error(t, err) {
+			assert.True(t, strings.Contains(err.Error(), c.want), err.Error())
+		}
+	}
+
+	// the migrations of this build are in order
+	_, err = pendingMigrations((&mongoDB{}).migrations(), nil)
+	assert.NoError(t, err)
+}
+
+func TestMigrateIndexes(t *testing.T) {
+	mongo := &mongoDB{}
+	mongo.EventExpire = time.Minute
+
+	// the migrations create indexes() and nothing else
+	created := []string{}
+	for _, m := range mongo.migrations() {
+		for _, idx := range m.Indexes {
+			created = append(created, idx.Collection+":"+strings.Join(idx.Index.Key, ","))
+		}
+	}
+	want := []string{}
+	for _, idx := range mongo.indexes() {
+		want = append(want, idx.Collection+":"+strings.Join(idx.Index.Key, ","))
+	}
+	assert.ElementsMatch(t, want, created)
+	assert.Equal(t, time.Minute, mongo.migrations()[0].Indexes[2].Index.ExpireAfter)
+}
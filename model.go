@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"math"
 	"time"
 
@@ -22,12 +23,25 @@ type distanceReq struct {
 
 type (
 	geoUser struct {
-		ID     bson.ObjectId `form:"_id" bson:"_id,omitempty"`
-		Name   string        `form:"name" bson:"name,omitempty"`
-		Text   string        `form:"text" bson:"text,omitempty"`
-		Tags   []string      `form:"tags" bson:"tags,omitempty"`
-		Email  string        `form:"email" bson:"email,omitempty"`
-		Events []mgo.DBRef   `form:"events" bson:"events,omitempty"`
+		ID      bson.ObjectId `form:"_id" json:"_id,omitempty" bson:"_id,omitempty"`
+		Name    string        `form:"name" json:"name,omitempty" bson:"name,omitempty" binding:"max=256"`
+		Text    string        `form:"text" json:"text,omitempty" bson:"text,omitempty" binding:"max=4096"`
+		Tags    []string      `form:"tags" json:"tags,omitempty" bson:"tags,omitempty" binding:"max=32,dive,max=64"`
+		Email   string        `form:"email" json:"email,omitempty" bson:"email,omitempty" binding:"omitempty,email"`
+		Events  []mgo.DBRef   `form:"events" json:"events,omitempty" bson:"events,omitempty"`
+		Version int64         `form:"version" json:"version" bson:"version"`
+		// Role and Moderation are only written by the admin api
+		Role       string       `form:"role" json:"role,omitempty" bson:"role,omitempty" binding:"omitempty,oneof=user moderator admin"`
+		Moderation *moderation  `form:"-" json:"moderation,omitempty" bson:"moderation,omitempty"`
+		Privacy    *privacy     `form:"-" json:"privacy,omitempty" bson:"privacy,omitempty"`
+		Notify     *notifyPrefs `form:"-" json:"notify,omitempty" bson:"notify,omitempty"`
+	}
+
+	// privacy is who sees the location of a user and how close, empty
+	// fields take the server defaults
+	privacy struct {
+		Visibility string `json:"visibility,omitempty" bson:"visibility,omitempty" binding:"omitempty,oneof=public friends hidden"`
+		Precision  string `json:"precision,omitempty" bson:"precision,omitempty" binding:"omitempty,oneof=exact grid geohash"`
 	}
 )
 
@@ -36,13 +50,57 @@ type (
 // Event struct for processing events
 type (
 	geoEvent struct {
-		ID        bson.ObjectId `form:"_id" bson:"_id,omitempty"`
-		Name      string        `form:"name" bson:"name,omitempty"`
-		Text      string        `form:"text" bson:"text,omitempty"`
-		Tags      []string      `form:"tags" bson:"tags,omitempty"`
-		TTLEvent  time.Time     `form:"ttl" bson:"ttl,omitempty"`
-		Timestamp time.Time     `form:"timestamp" bson:"timestamp,omitempty"`
-		Users     []mgo.DBRef   `form:"users" bson:"users,omitempty"`
+		ID         bson.ObjectId `form:"_id" json:"_id,omitempty" bson:"_id,omitempty"`
+		Name       string        `form:"name" json:"name,omitempty" bson:"name,omitempty" binding:"max=256"`
+		Text       string        `form:"text" json:"text,omitempty" bson:"text,omitempty" binding:"max=4096"`
+		Tags       []string      `form:"tags" json:"tags,omitempty" bson:"tags,omitempty" binding:"max=32,dive,max=64"`
+		TTLEvent   time.Time     `form:"ttl" json:"ttl,omitempty" bson:"ttl,omitempty"`
+		Timestamp  time.Time     `form:"timestamp" json:"timestamp,omitempty" bson:"timestamp,omitempty"`
+		Users      []mgo.DBRef   `form:"users" json:"users,omitempty" bson:"users,omitempty"`
+		ExternalID string        `form:"external_id" json:"external_id,omitempty" bson:"external_id,omitempty" binding:"max=128"`
+		Version    int64         `form:"version" json:"version" bson:"version"`
+		Moderation *moderation   `form:"-" json:"moderation,omitempty" bson:"moderation,omitempty"`
+	}
+)
+
+// ========== legacy members
+
+// legacyUser and legacyEvent are geoUser and geoEvent as the routes that
+// predate the /{id} ones (the collection routes, /all and /locs/geoevent)
+// read and send them, with the Go field names of before the json tags. The
+// conversions between them only compile while the fields match.
+type (
+	legacyUser struct {
+		ID         bson.ObjectId `form:"_id" json:"ID" bson:"_id,omitempty"`
+		Name       string        `form:"name" json:"Name" bson:"name,omitempty" binding:"max=256"`
+		Text       string        `form:"text" json:"Text" bson:"text,omitempty" binding:"max=4096"`
+		Tags       []string      `form:"tags" json:"Tags" bson:"tags,omitempty" binding:"max=32,dive,max=64"`
+		Email      string        `form:"email" json:"Email" bson:"email,omitempty" binding:"omitempty,email"`
+		Events     []mgo.DBRef   `form:"events" json:"Events" bson:"events,omitempty"`
+		Version    int64         `form:"version" json:"version" bson:"version"`
+		Role       string        `form:"role" json:"role,omitempty" bson:"role,omitempty" binding:"omitempty,oneof=user moderator admin"`
+		Moderation *moderation   `form:"-" json:"moderation,omitempty" bson:"moderation,omitempty"`
+		Privacy    *privacy      `form:"-" json:"privacy,omitempty" bson:"privacy,omitempty"`
+		Notify     *notifyPrefs  `form:"-" json:"notify,omitempty" bson:"notify,omitempty"`
+	}
+
+	legacyEvent struct {
+		ID         bson.ObjectId `form:"_id" json:"ID" bson:"_id,omitempty"`
+		Name       string        `form:"name" json:"Name" bson:"name,omitempty" binding:"max=256"`
+		Text       string        `form:"text" json:"Text" bson:"text,omitempty" binding:"max=4096"`
+		Tags       []string      `form:"tags" json:"Tags" bson:"tags,omitempty" binding:"max=32,dive,max=64"`
+		TTLEvent   time.Time     `form:"ttl" json:"TTLEvent" bson:"ttl,omitempty"`
+		Timestamp  time.Time     `form:"timestamp" json:"Timestamp" bson:"timestamp,omitempty"`
+		Users      []mgo.DBRef   `form:"users" json:"Users" bson:"users,omitempty"`
+		ExternalID string        `form:"external_id" json:"external_id,omitempty" bson:"external_id,omitempty" binding:"max=128"`
+		Version    int64         `form:"version" json:"version" bson:"version"`
+		Moderation *moderation   `form:"-" json:"moderation,omitempty" bson:"moderation,omitempty"`
+	}
+
+	// legacyGeoEvent is reqGeoEvent with a legacyEvent
+	legacyGeoEvent struct {
+		GeoLoc geoLocation `form:"geoloc" json:"geoloc,omitempty"`
+		Event  legacyEvent `form:"event" json:"event,omitempty"`
 	}
 )
 
@@ -50,15 +108,34 @@ type (
 
 // id GeoLocation should be id user/event
 type (
+	// coordinates hold a single [lng, lat] position, so Point is the only
+	// GeoJSON type they can describe
 	geoObject struct {
-		Type        string     `json:"type,omitempty"`
-		Coordinates [2]float64 `json:"coordinates,omitempty"`
+		Type        string     `json:"type,omitempty" binding:"omitempty,oneof=Point"`
+		Coordinates [2]float64 `json:"coordinates,omitempty" binding:"lnglat"`
 	}
 
 	geoLocation struct {
-		ID       bson.ObjectId `form:"_id" json:"_id,omitempty" bson:"_id,omitempty"`
-		TObject  string        `form:"tobject" json:"tobject,omitempty" bson:"tobject,omitempty"`
-		Location geoObject     `form:"location" json:"location,omitempty" bson:"location,omitempty"`
+		ID         bson.ObjectId `form:"_id" json:"_id,omitempty" bson:"_id,omitempty"`
+		TObject    string        `form:"tobject" json:"tobject,omitempty" bson:"tobject,omitempty" binding:"omitempty,oneof=User Event"`
+		Location   geoObject     `form:"location" json:"location,omitempty" bson:"location,omitempty"`
+		ExternalID string        `form:"external_id" json:"external_id,omitempty" bson:"external_id,omitempty" binding:"max=128"`
+		FixTime    time.Time     `form:"fix_ts" json:"fix_ts,omitempty" bson:"fix_ts,omitempty"`
+		Accuracy   float64       `form:"accuracy" json:"accuracy,omitempty" bson:"accuracy,omitempty"`
+		Speed      float64       `form:"speed" json:"speed,omitempty" bson:"speed,omitempty"`
+		Version    int64         `form:"version" json:"version" bson:"version"`
+		Moderation *moderation   `form:"-" json:"moderation,omitempty" bson:"moderation,omitempty"`
+	}
+
+	// fix is one device position report of the ingest endpoint, ts is in
+	// unix milliseconds and the device is the location external_id
+	fix struct {
+		Device   string  `json:"device"`
+		TS       int64   `json:"ts"`
+		Lat      float64 `json:"lat"`
+		Lng      float64 `json:"lng"`
+		Accuracy float64 `json:"accuracy,omitempty"`
+		Speed    float64 `json:"speed,omitempty"`
 	}
 
 	respondID struct {
@@ -71,19 +148,40 @@ type (
 	}
 
 	reqNear struct {
-		Scope float64 `form:"scope" json:"scope,omitempty"`
-		TGeos string  `form:"tgeos" json:"tgeos,omitempty"`
-		Lat   float64 `form:"lat" json:"lat,omitempty"`
-		Lng   float64 `form:"lng" json:"lng,omitempty"`
+		Scope float64 `form:"scope" json:"scope,omitempty" binding:"gt=0"`
+		TGeos string  `form:"tgeos" json:"tgeos,omitempty" binding:"omitempty,oneof=Point"`
+		Lat   float64 `form:"lat" json:"lat,omitempty" binding:"min=-90,max=90"`
+		Lng   float64 `form:"lng" json:"lng,omitempty" binding:"min=-180,max=180"`
+	}
+
+	reqBBox struct {
+		MinLng float64 `json:"minLng" binding:"min=-180,max=180,ltfield=MaxLng"`
+		MinLat float64 `json:"minLat" binding:"min=-90,max=90,ltfield=MaxLat"`
+		MaxLng float64 `json:"maxLng" binding:"min=-180,max=180"`
+		MaxLat float64 `json:"maxLat" binding:"min=-90,max=90"`
+		Limit  int     `json:"limit" binding:"gt=0,max=10000"`
 	}
 
 	reqFilter struct {
-		TObject string   `form:"tobject" json:"tobject,omitempty"`
-		Scope   float64  `form:"scope" json:"scope,omitempty"`
-		TTime   string   `form:"ttime" json:"ttime,omitempty"`
-		Tags    []string `form:"tags" json:"tags,omitempty"`
-		Lat     float64  `form:"lat" json:"lat,omitempty"`
-		Lng     float64  `form:"lng" json:"lng,omitempty"`
+		TObject string   `form:"tobject" json:"tobject,omitempty" binding:"omitempty,oneof=Any User Event"`
+		Scope   float64  `form:"scope" json:"scope,omitempty" binding:"gt=0"`
+		TTime   string   `form:"ttime" json:"ttime,omitempty" binding:"omitempty,oneof=Any Recently Today Yesterday Week Month"`
+		Tags    []string `form:"tags" json:"tags,omitempty" binding:"max=32"`
+		Lat     float64  `form:"lat" json:"lat,omitempty" binding:"min=-90,max=90"`
+		Lng     float64  `form:"lng" json:"lng,omitempty" binding:"min=-180,max=180"`
+	}
+
+	// reqBulk items are decoded one by one, so a bad item fails alone
+	reqBulk struct {
+		Items  []json.RawMessage `json:"items" binding:"required,min=1"`
+		Upsert bool              `json:"upsert"`
+	}
+
+	bulkItemResult struct {
+		Index      int           `json:"index"`
+		ID         bson.ObjectId `json:"_id,omitempty"`
+		ExternalID string        `json:"external_id,omitempty"`
+		Error      *problem      `json:"error,omitempty"`
 	}
 
 	eventLoc struct {
@@ -97,6 +195,263 @@ type (
 	}
 )
 
+// ========== notifications
+
+type (
+	// notifyPrefs is what a user is told about and how, Radius is meters
+	// around its own location and empty Channels take the server defaults
+	notifyPrefs struct {
+		Events   bool        `json:"events,omitempty" bson:"events,omitempty"`
+		Friends  bool        `json:"friends,omitempty" bson:"friends,omitempty"`
+		Radius   float64     `json:"radius,omitempty" bson:"radius,omitempty" binding:"min=0,max=100000"`
+		Tags     []string    `json:"tags,omitempty" bson:"tags,omitempty" binding:"max=32,dive,max=64"`
+		Channels []string    `json:"channels,omitempty" bson:"channels,omitempty" binding:"max=4,dive,oneof=log file webhook smtp"`
+		Webhook  string      `json:"webhook,omitempty" bson:"webhook,omitempty" binding:"omitempty,url,publicurl,max=2048"`
+		Quiet    *quietHours `json:"quiet,omitempty" bson:"quiet,omitempty"`
+	}
+
+	// quietHours defer deliveries from Start to End, both "15:04" in TZ
+	quietHours struct {
+		Start string `json:"start" bson:"start" binding:"datetime=15:04"`
+		End   string `json:"end" bson:"end" binding:"datetime=15:04"`
+		TZ    string `json:"tz,omitempty" bson:"tz,omitempty" binding:"omitempty,timezone"`
+	}
+
+	// notification is an outbox entry, one per recipient and channel. Key
+	// is unique so the same news is queued once.
+	notification struct {
+		ID        bson.ObjectId `json:"_id" bson:"_id"`
+		Key       string        `json:"-" bson:"key"`
+		User      bson.ObjectId `json:"user" bson:"user"`
+		Kind      string        `json:"kind" bson:"kind"`
+		Channel   string        `json:"channel" bson:"channel"`
+		Subject   bson.ObjectId `json:"subject" bson:"subject"`
+		Text      string        `json:"text" bson:"text"`
+		Location  geoObject     `json:"location" bson:"location"`
+		Distance  float64       `json:"distance" bson:"distance"`
+		Status    string        `json:"status" bson:"status"`
+		Attempts  int           `json:"attempts" bson:"attempts"`
+		NextAt    time.Time     `json:"next_at" bson:"next_at"`
+		LastError string        `json:"last_error,omitempty" bson:"last_error,omitempty"`
+		CreatedAt time.Time     `json:"created_at" bson:"created_at"`
+		SentAt    time.Time     `json:"sent_at,omitempty" bson:"sent_at,omitempty"`
+	}
+
+	reqNotifications struct {
+		Status string `form:"status" binding:"omitempty,oneof=pending sending sent failed canceled"`
+		Limit  int    `form:"limit,default=100" binding:"min=1,max=1000"`
+	}
+)
+
+// ========== relations
+
+type (
+	// relation is a follow of To by From, or a friend request From sent To
+	// that is pending until To sends one back
+	relation struct {
+		ID         bson.ObjectId `json:"_id" bson:"_id"`
+		Kind       string        `json:"kind" bson:"kind"`
+		From       bson.ObjectId `json:"from" bson:"from"`
+		To         bson.ObjectId `json:"to" bson:"to"`
+		Status     string        `json:"status" bson:"status"`
+		CreatedAt  time.Time     `json:"created_at" bson:"created_at"`
+		AcceptedAt time.Time     `json:"accepted_at,omitempty" bson:"accepted_at,omitempty"`
+		// Pair is the two users of a friend relation in either direction,
+		// unique so crossing requests meet in one document
+		Pair string `json:"-" bson:"pair,omitempty"`
+	}
+
+	reqRelations struct {
+		Skip  int `form:"skip" binding:"min=0"`
+		Limit int `form:"limit,default=100" binding:"min=1,max=1000"`
+	}
+)
+
+// ========== moderation
+
+type (
+	// moderation is set on a hidden event or location and a suspended user
+	moderation struct {
+		Hidden    bool      `json:"hidden,omitempty" bson:"hidden,omitempty"`
+		Suspended bool      `json:"suspended,omitempty" bson:"suspended,omitempty"`
+		Reason    string    `json:"reason,omitempty" bson:"reason,omitempty"`
+		By        string    `json:"by" bson:"by"`
+		At        time.Time `json:"at" bson:"at"`
+	}
+
+	// report is an entry of the moderation queue, open until an action on
+	// its target resolves it
+	report struct {
+		ID         bson.ObjectId `json:"_id" bson:"_id"`
+		Kind       string        `json:"kind" bson:"kind"`
+		Target     bson.ObjectId `json:"target" bson:"target"`
+		Reason     string        `json:"reason" bson:"reason"`
+		By         string        `json:"by,omitempty" bson:"by,omitempty"`
+		CreatedAt  time.Time     `json:"created_at" bson:"created_at"`
+		ResolvedAt time.Time     `json:"resolved_at,omitempty" bson:"resolved_at,omitempty"`
+		Resolution string        `json:"resolution,omitempty" bson:"resolution,omitempty"`
+		ResolvedBy string        `json:"resolved_by,omitempty" bson:"resolved_by,omitempty"`
+	}
+
+	reqReport struct {
+		Reason string `json:"reason" binding:"required,max=1024"`
+	}
+
+	reqModerate struct {
+		Reason string `json:"reason" binding:"max=1024"`
+	}
+
+	reqRole struct {
+		Role string `json:"role" binding:"required,oneof=user moderator admin"`
+	}
+
+	reqQueue struct {
+		Kind     string `form:"kind" binding:"omitempty,oneof=user event location"`
+		Resolved bool   `form:"resolved"`
+		Limit    int    `form:"limit,default=100" binding:"min=1,max=1000"`
+	}
+)
+
+// ========== audit
+
+type (
+	// auditEntry is one write to a user, event or location, entries are only
+	// ever appended and expire after the retention
+	auditEntry struct {
+		ID        bson.ObjectId `json:"_id" bson:"_id"`
+		At        time.Time     `json:"at" bson:"at"`
+		Actor     string        `json:"actor" bson:"actor"`
+		Action    string        `json:"action" bson:"action"`
+		Kind      string        `json:"kind" bson:"kind"`
+		Entity    bson.ObjectId `json:"entity" bson:"entity"`
+		Route     string        `json:"route,omitempty" bson:"route,omitempty"`
+		RequestID string        `json:"request_id,omitempty" bson:"request_id,omitempty"`
+		Changes   []auditChange `json:"changes,omitempty" bson:"changes,omitempty"`
+	}
+
+	auditChange struct {
+		Field  string      `json:"field" bson:"field"`
+		Before interface{} `json:"before,omitempty" bson:"before,omitempty"`
+		After  interface{} `json:"after,omitempty" bson:"after,omitempty"`
+	}
+
+	reqAudit struct {
+		Kind   string    `form:"kind" binding:"omitempty,oneof=user event location"`
+		Entity string    `form:"entity" binding:"omitempty,len=24,hexadecimal"`
+		Actor  string    `form:"actor" binding:"max=64"`
+		Since  time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+		Until  time.Time `form:"until" time_format:"2006-01-02T15:04:05Z07:00"`
+		Limit  int       `form:"limit,default=100" binding:"min=1,max=1000"`
+	}
+)
+
+// ========== webhooks
+
+type (
+	// webhook is a subscription of another system to the writes of Events,
+	// "*" or "<kind>.*" match many. With Area or Tags only writes to users,
+	// events and locations in the area or with one of the tags are sent.
+	webhook struct {
+		ID          bson.ObjectId `json:"_id" bson:"_id"`
+		URL         string        `json:"url" bson:"url"`
+		Description string        `json:"description,omitempty" bson:"description,omitempty"`
+		Secret      string        `json:"-" bson:"secret"`
+		Events      []string      `json:"events" bson:"events"`
+		Area        *hookArea     `json:"area,omitempty" bson:"area,omitempty"`
+		Tags        []string      `json:"tags,omitempty" bson:"tags,omitempty"`
+		CreatedAt   time.Time     `json:"created_at" bson:"created_at"`
+		RotatedAt   time.Time     `json:"rotated_at,omitempty" bson:"rotated_at,omitempty"`
+	}
+
+	// webhookSecret is a webhook as it is created or rotated, the only
+	// time its signing secret is shown
+	webhookSecret struct {
+		webhook
+		Secret string `json:"secret"`
+	}
+
+	hookArea struct {
+		MinLng float64 `json:"minLng" bson:"min_lng" binding:"min=-180,max=180,ltfield=MaxLng"`
+		MinLat float64 `json:"minLat" bson:"min_lat" binding:"min=-90,max=90,ltfield=MaxLat"`
+		MaxLng float64 `json:"maxLng" bson:"max_lng" binding:"min=-180,max=180"`
+		MaxLat float64 `json:"maxLat" bson:"max_lat" binding:"min=-90,max=90"`
+	}
+
+	reqWebhook struct {
+		URL         string    `json:"url" binding:"required,url,max=2048"`
+		Description string    `json:"description" binding:"max=256"`
+		Events      []string  `json:"events" binding:"required,min=1,max=64,dive,hookevent"`
+		Area        *hookArea `json:"area"`
+		Tags        []string  `json:"tags" binding:"max=32,dive,max=64"`
+	}
+
+	// delivery is an event on its way to a webhook, Body is what is signed
+	// and sent on every attempt. After MaxAttempts it is dead until replayed.
+	delivery struct {
+		ID          bson.ObjectId `json:"_id" bson:"_id"`
+		Hook        bson.ObjectId `json:"hook" bson:"hook"`
+		Event       bson.ObjectId `json:"event" bson:"event"`
+		Type        string        `json:"type" bson:"type"`
+		Entity      bson.ObjectId `json:"entity" bson:"entity"`
+		Body        string        `json:"body" bson:"body"`
+		Status      string        `json:"status" bson:"status"`
+		Attempts    int           `json:"attempts" bson:"attempts"`
+		NextAt      time.Time     `json:"next_at" bson:"next_at"`
+		LastStatus  int           `json:"last_status,omitempty" bson:"last_status,omitempty"`
+		LastError   string        `json:"last_error,omitempty" bson:"last_error,omitempty"`
+		CreatedAt   time.Time     `json:"created_at" bson:"created_at"`
+		DeliveredAt time.Time     `json:"delivered_at,omitempty" bson:"delivered_at,omitempty"`
+	}
+
+	reqDeliveries struct {
+		Status string `form:"status" binding:"omitempty,oneof=pending sending delivered dead"`
+		Limit  int    `form:"limit,default=100" binding:"min=1,max=1000"`
+	}
+
+	// reqReplay replays one delivery, or all the dead ones of the webhook
+	reqReplay struct {
+		Delivery string `form:"delivery" binding:"omitempty,len=24,hexadecimal"`
+	}
+)
+
+// ========== api keys
+
+type (
+	// apiKey is a machine credential, only the sha256 of the key is stored
+	// and Prefix is the public part used to find it
+	apiKey struct {
+		ID     bson.ObjectId `json:"_id" bson:"_id"`
+		Name   string        `json:"name" bson:"name"`
+		Prefix string        `json:"prefix" bson:"prefix"`
+		Hash   string        `json:"-" bson:"hash"`
+		// UserID is the owner of the key, the key acts with the owner's role
+		UserID     bson.ObjectId `json:"user_id,omitempty" bson:"user_id,omitempty"`
+		Scopes     []string      `json:"scopes" bson:"scopes"`
+		AllowIPs   []string      `json:"allow_ips,omitempty" bson:"allow_ips,omitempty"`
+		ExpiresAt  time.Time     `json:"expires_at,omitempty" bson:"expires_at,omitempty"`
+		CreatedAt  time.Time     `json:"created_at" bson:"created_at"`
+		RotatedAt  time.Time     `json:"rotated_at,omitempty" bson:"rotated_at,omitempty"`
+		RevokedAt  time.Time     `json:"revoked_at,omitempty" bson:"revoked_at,omitempty"`
+		LastUsedAt time.Time     `json:"last_used_at,omitempty" bson:"last_used_at,omitempty"`
+		LastUsedIP string        `json:"last_used_ip,omitempty" bson:"last_used_ip,omitempty"`
+	}
+
+	// apiKeySecret is what create and rotate answer, the only time the key
+	// is shown
+	apiKeySecret struct {
+		apiKey
+		Key string `json:"key"`
+	}
+
+	reqAPIKey struct {
+		Name      string    `json:"name" binding:"required,max=128"`
+		UserID    string    `json:"user_id" binding:"omitempty,len=24,hexadecimal"`
+		Scopes    []string  `json:"scopes" binding:"required,min=1,max=16,dive,scope"`
+		AllowIPs  []string  `json:"allow_ips" binding:"max=32,dive,cidr|ip"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+)
+
 func distance(locFrom, locTo *geoLocation) float64 {
 	return math.Sqrt(
 		math.Pow(locFrom.Location.Coordinates[0]-locTo.Location.Coordinates[0], 2) +
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========== roles
+
+// requireRole lets requests through whose key acts with one of roles
+func requireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("role")
+		if !contains(roles, role) {
+			abortError(c, errForbidden("role_missing",
+				"this route needs one of the roles: "+strings.Join(roles, ", ")))
+			return
+		}
+		c.Next()
+	}
+}
+
+// actor names who made a request, the owner of its key or the key itself
+func actor(c *gin.Context) string {
+	return keyActor(c.GetString("key_id"), c.GetString("user_id"), "anonymous")
+}
+
+// keyActor is actor for a key id and owner id that may be empty, anonymous
+// names requests without a key
+func keyActor(keyID, userID, anonymous string) string {
+	if userID != "" {
+		return "user:" + userID
+	}
+	if keyID != "" {
+		return "key:" + keyID
+	}
+	return anonymous
+}
+
+// ========== moderation
+
+// moderationTarget is what a moderation action applies to, the location
+// that shares the id follows users and events
+type moderationTarget struct {
+	kind  string
+	colls []string
+}
+
+var (
+	modUser     = moderationTarget{"user", []string{"dviUsers", "dviLocations"}}
+	modEvent    = moderationTarget{"event", []string{"dviEvents", "dviLocations"}}
+	modLocation = moderationTarget{"location", []string{"dviLocations"}}
+)
+
+// postModerate hides or suspends, with on false it lifts the action again
+func postModerate(mongo *mongoDB, ring *keyring, target moderationTarget, action string, on bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		var req reqModerate
+		if c.Request.ContentLength != 0 {
+			err = c.ShouldBindJSON(&req)
+			if err != nil {
+				abortError(c, errValidation("invalid_request", err))
+				return
+			}
+		}
+
+		var mod *moderation
+		if on {
+			mod = &moderation{Reason: req.Reason, By: actor(c), At: time.Now()}
+			mod.Hidden = target.kind != "user"
+			mod.Suspended = target.kind == "user"
+		}
+		doc, err := mongo.moderate(c.Request.Context(), target, id, mod, action)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		if target.kind == "user" {
+			ring.forget(id)
+		}
+
+		requestLogger(c).Info("moderation", "action", action, "kind", target.kind,
+			"target", id.Hex(), "actor", actor(c), "reason", req.Reason)
+		c.JSON(http.StatusOK, gin.H{"msg": action + " " + target.kind + " complete", "body": doc})
+	}
+}
+
+// putRole sets the role of a user, only admins hand out roles
+func putRole(mongo *mongoDB, ring *keyring) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		var req reqRole
+		err = c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		user, err := mongo.setRole(c.Request.Context(), id, req.Role)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		ring.forget(id)
+
+		requestLogger(c).Info("moderation", "action", "role", "kind", "user",
+			"target", id.Hex(), "actor", actor(c), "role", req.Role)
+		c.JSON(http.StatusOK, gin.H{"msg": "put role complete", "body": user})
+	}
+}
+
+func getQueue(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req reqQueue
+		err := c.ShouldBindQuery(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		reports, err := mongo.getReports(c.Request.Context(), &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get queue complete", "body": reports})
+	}
+}
+
+// postReport puts a user, event or location in the moderation queue
+func postReport(mongo *mongoDB, kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		var req reqReport
+		err = c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		r := report{Kind: kind, Target: id, Reason: req.Reason, CreatedAt: time.Now()}
+		if by := actor(c); by != "anonymous" {
+			r.By = by
+		}
+		err = mongo.postReport(c.Request.Context(), &r)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "post report complete", "body": r})
+	}
+}
@@ -0,0 +1,551 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== notifications
+
+var notifyChannels = []string{"log", "file", "webhook", "smtp"}
+
+// notifier matches the changes of the bus with the preferences of users
+// into the outbox and delivers the outbox through the sinks
+type notifier struct {
+	mongo *mongoDB
+	conf  *notifyConfig
+	sinks map[string]sink
+}
+
+func newNotifier(mongo *mongoDB, conf *notifyConfig) *notifier {
+	return &notifier{
+		mongo: mongo,
+		conf:  conf,
+		sinks: newSinks(conf),
+	}
+}
+
+// run matches changes and polls the outbox until ctx is done. The bus
+// keeps QueueSize changes for it, when the matcher falls behind more are
+// dropped.
+func (n *notifier) run(ctx context.Context) {
+	unsubscribe := n.mongo.bus.subscribe(ctx, "notify", n.conf.QueueSize, func(ctx context.Context, c change) {
+		err := n.match(ctx, c)
+		if err != nil {
+			slog.Error("notify match", "error", err.Error(), "change", c.Type, "entity", c.Entity.Hex())
+		}
+	})
+	defer unsubscribe()
+
+	ticker := time.NewTicker(n.conf.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			n.deliver(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ========== matching
+
+// newsActions are the changes that may be news: a new event, or a user
+// location that moved
+var newsActions = []string{"create", "update", "upsert", "move"}
+
+func (n *notifier) match(ctx context.Context, c change) (err error) {
+	if !contains(newsActions, c.Action) || (c.Kind == "event" && c.Action != "create") {
+		return nil
+	}
+	var notes []notification
+	switch c.Kind {
+	case "event":
+		var event geoEvent
+		err = c.decode(&event)
+		if err != nil {
+			return err
+		}
+		var at geoLocation
+		at, err = n.mongo.readLoc(ctx, &geoLocation{ID: c.Entity})
+		if err == mgo.ErrNotFound {
+			// an event without a location is nowhere near anyone
+			return nil
+		} else if err != nil {
+			return err
+		}
+		notes, err = n.eventNearby(ctx, &event, at)
+	case "location":
+		var loc geoLocation
+		err = c.decode(&loc)
+		if err != nil || loc.TObject != "User" || loc.Moderation != nil {
+			return err
+		}
+		notes, err = n.friendNearby(ctx, loc)
+	}
+	if err != nil || len(notes) == 0 {
+		return err
+	}
+	return n.mongo.postNotifications(ctx, notes)
+}
+
+// eventNearby tells the users that want events about a new one within
+// their radius and tags
+func (n *notifier) eventNearby(ctx context.Context, event *geoEvent, at geoLocation) (notes []notification, err error) {
+	users, locs, err := n.mongo.getUsersNear(ctx, at.Location, n.conf.MaxRadius, "notify.events")
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		prefs, home := u.Notify, locs[u.ID].Location
+		d := sphereDistance(home.Coordinates[0], home.Coordinates[1],
+			at.Location.Coordinates[0], at.Location.Coordinates[1])
+		if d > prefs.Radius || (len(prefs.Tags) > 0 && !overlaps(prefs.Tags, event.Tags)) {
+			continue
+		}
+		text := fmt.Sprintf("new event %q %s away", event.Name, meters(d))
+		notes = append(notes, n.outbox(&u, "event_nearby", event.ID,
+			"event_nearby:"+u.ID.Hex()+":"+event.ID.Hex(), text, at.Location, d)...)
+	}
+	return notes, nil
+}
+
+// friendNearby tells the friends of the user at loc that want it that it
+// is within their radius, at most once a FriendCooldown. They see loc as
+// the privacy of the user lets a friend see it.
+func (n *notifier) friendNearby(ctx context.Context, loc geoLocation) (notes []notification, err error) {
+	friends, err := n.mongo.friendIDs(ctx, loc.ID, nil)
+	if err != nil || len(friends) == 0 {
+		return nil, err
+	}
+	mover, err := n.mongo.getUser(ctx, &geoUser{ID: loc.ID})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]bson.ObjectId, 0, len(friends))
+	for id := range friends {
+		ids = append(ids, id)
+	}
+	users, locs, err := n.mongo.getUsersByIDWithLocs(ctx, ids, "notify.friends")
+	if err != nil {
+		return nil, err
+	}
+
+	s := n.mongo.Privacy.settings(mover.Privacy)
+	window := time.Now().Truncate(n.conf.FriendCooldown).Unix()
+	for _, u := range users {
+		show, fuzzed := viewer{User: u.ID}.show(mover.ID, s, true)
+		if !show {
+			continue
+		}
+		at := loc.Location
+		if fuzzed {
+			at = n.mongo.Privacy.fuzz(s.Precision, at)
+		}
+		home := locs[u.ID].Location
+		d := sphereDistance(home.Coordinates[0], home.Coordinates[1], at.Coordinates[0], at.Coordinates[1])
+		if d > u.Notify.Radius {
+			continue
+		}
+		name := mover.Name
+		if name == "" {
+			name = "a friend"
+		}
+		text := fmt.Sprintf("%s is %s away", name, meters(d))
+		key := fmt.Sprintf("friend_nearby:%s:%s:%d", u.ID.Hex(), mover.ID.Hex(), window)
+		notes = append(notes, n.outbox(&u, "friend_nearby", mover.ID, key, text, at, d)...)
+	}
+	return notes, nil
+}
+
+// outbox is a pending notification to user on each of its channels that
+// is on, or on the default channels
+func (n *notifier) outbox(user *geoUser, kind string, subject bson.ObjectId, key, text string, at geoObject, d float64) (notes []notification) {
+	channels := user.Notify.Channels
+	if len(channels) == 0 {
+		channels = n.conf.Channels
+	}
+	now := time.Now()
+	for _, ch := range channels {
+		if n.sinks[ch] == nil {
+			continue
+		}
+		notes = append(notes, notification{
+			ID:        bson.NewObjectId(),
+			Key:       key + ":" + ch,
+			User:      user.ID,
+			Kind:      kind,
+			Channel:   ch,
+			Subject:   subject,
+			Text:      text,
+			Location:  at,
+			Distance:  d,
+			Status:    "pending",
+			NextAt:    now,
+			CreatedAt: now,
+		})
+	}
+	return notes
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		if contains(b, x) {
+			return true
+		}
+	}
+	return false
+}
+
+func meters(d float64) string {
+	if d < 1000 {
+		return fmt.Sprintf("%.0f m", d)
+	}
+	return fmt.Sprintf("%.1f km", d/1000)
+}
+
+// ========== delivery
+
+// deliver sends up to BatchSize due notifications one by one
+func (n *notifier) deliver(ctx context.Context) {
+	for i := 0; i < n.conf.BatchSize && ctx.Err() == nil; i++ {
+		note, err := n.mongo.claimNotification(ctx, time.Now(), n.conf.Lease)
+		if err == mgo.ErrNotFound {
+			return
+		}
+		if err != nil {
+			slog.Error("notify claim", "error", err.Error())
+			return
+		}
+		n.send(ctx, &note)
+	}
+}
+
+// send delivers a claimed note and records how it went. A note that can
+// not be sent any more is canceled, one in quiet hours waits for their
+// end without using an attempt.
+func (n *notifier) send(ctx context.Context, note *notification) {
+	user, err := n.mongo.getUser(ctx, &geoUser{ID: note.User})
+	snk := n.sinks[note.Channel]
+	switch {
+	case err == mgo.ErrNotFound || snk == nil || (err == nil && user.Notify == nil):
+		n.finish(ctx, note, "canceled", bson.M{})
+		return
+	case err != nil:
+		// the lease runs out and it is claimed again
+		slog.Error("notify user", "error", err.Error(), "notification", note.ID.Hex())
+		return
+	}
+	if until, quiet := user.Notify.Quiet.until(time.Now()); quiet {
+		n.finish(ctx, note, "pending", bson.M{"next_at": until})
+		return
+	}
+
+	sctx, cancel := context.WithTimeout(ctx, n.conf.Lease)
+	err = snk.send(sctx, &user, note)
+	cancel()
+	if err == nil {
+		n.finish(ctx, note, "sent", bson.M{"sent_at": time.Now(), "attempts": note.Attempts + 1})
+		return
+	}
+
+	attempts := note.Attempts + 1
+	set := bson.M{"attempts": attempts, "last_error": err.Error()}
+	var perm *permanentError
+	if errors.As(err, &perm) || attempts >= n.conf.MaxAttempts {
+		n.finish(ctx, note, "failed", set)
+		return
+	}
+	set["next_at"] = time.Now().Add(backoff(n.conf.BackoffMin, n.conf.BackoffMax, attempts))
+	n.finish(ctx, note, "pending", set)
+}
+
+func (n *notifier) finish(ctx context.Context, note *notification, status string, set bson.M) {
+	result := status
+	if status == "pending" && set["last_error"] != nil {
+		result = "retried"
+	}
+	metrics.notifications.WithLabelValues(note.Channel, result).Inc()
+	set["status"] = status
+	err := n.mongo.updateNotification(ctx, note.ID, set)
+	if err != nil {
+		slog.Error("notify finish", "error", err.Error(), "notification", note.ID.Hex())
+	}
+}
+
+// backoff is the wait before attempt+1, doubling from min up to max with
+// up to half of it random so retries of many notes spread out
+func backoff(min, max time.Duration, attempt int) time.Duration {
+	d := max
+	if attempt < 20 && min<<(attempt-1) < max {
+		d = min << (attempt - 1)
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// until tells if now is in the quiet hours and when they end, q may be nil
+func (q *quietHours) until(now time.Time) (time.Time, bool) {
+	if q == nil {
+		return now, false
+	}
+	loc := time.UTC
+	if q.TZ != "" {
+		var err error
+		loc, err = time.LoadLocation(q.TZ)
+		if err != nil {
+			return now, false
+		}
+	}
+	start, err1 := time.Parse("15:04", q.Start)
+	end, err2 := time.Parse("15:04", q.End)
+	if err1 != nil || err2 != nil {
+		return now, false
+	}
+	t := now.In(loc)
+	s, e, m := start.Hour()*60+start.Minute(), end.Hour()*60+end.Minute(), t.Hour()*60+t.Minute()
+	quiet := (s < e && m >= s && m < e) || (s > e && (m >= s || m < e))
+	if !quiet {
+		return now, false
+	}
+	over := time.Date(t.Year(), t.Month(), t.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+	if !over.After(t) {
+		over = over.AddDate(0, 0, 1)
+	}
+	return over, true
+}
+
+// ========== sinks
+
+// sink delivers a notification on one channel
+type sink interface {
+	send(ctx context.Context, user *geoUser, note *notification) error
+}
+
+// permanentError is a delivery that no retry will fix
+type permanentError struct {
+	error
+}
+
+func newSinks(conf *notifyConfig) map[string]sink {
+	sinks := map[string]sink{}
+	for _, ch := range conf.Channels {
+		switch ch {
+		case "log":
+			sinks[ch] = logSink{}
+		case "file":
+			sinks[ch] = &fileSink{path: conf.File}
+		case "webhook":
+			sinks[ch] = webhookSink{client: publicClient(conf.WebhookTimeout), secret: conf.WebhookSecret}
+		case "smtp":
+			sinks[ch] = newSMTPSink(conf)
+		}
+	}
+	return sinks
+}
+
+type logSink struct{}
+
+func (logSink) send(ctx context.Context, user *geoUser, note *notification) error {
+	slog.InfoContext(ctx, "notification", "user", user.ID.Hex(), "kind", note.Kind,
+		"subject", note.Subject.Hex(), "text", note.Text)
+	return nil
+}
+
+// fileSink appends notifications to a file as json lines
+type fileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+func (f *fileSink) send(ctx context.Context, user *geoUser, note *notification) error {
+	data, err := json.Marshal(note)
+	if err != nil {
+		return &permanentError{err}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(data, '\n'))
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// webhookSink posts a notification as json to the webhook of the user,
+// signed like the deliveries of webhooks.go
+type webhookSink struct {
+	client *http.Client
+	secret string
+}
+
+func (w webhookSink) send(ctx context.Context, user *geoUser, note *notification) error {
+	if user.Notify.Webhook == "" {
+		return &permanentError{errors.New("user has no webhook")}
+	}
+	data, err := json.Marshal(note)
+	if err != nil {
+		return &permanentError{err}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, user.Notify.Webhook, bytes.NewReader(data))
+	if err != nil {
+		return &permanentError{err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Geoloc-Signature", signHook(w.secret, time.Now(), data))
+	req.Header.Set("X-Geoloc-Notification", note.ID.Hex())
+	res, err := w.client.Do(req)
+	if errors.Is(err, errPrivateTarget) {
+		return &permanentError{err}
+	} else if err != nil {
+		return err
+	}
+	res.Body.Close()
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode >= 400 && res.StatusCode < 500 &&
+		res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusRequestTimeout:
+		return &permanentError{fmt.Errorf("webhook answered %s", res.Status)}
+	}
+	return fmt.Errorf("webhook answered %s", res.Status)
+}
+
+// errPrivateTarget is a webhook on the server itself or in its network
+var errPrivateTarget = errors.New("webhook target is not public")
+
+// publicClient only connects to public addresses. The address is checked
+// as dialed, after dns and on every redirect, so a name can't resolve to
+// the network of the server.
+func publicClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+				return errPrivateTarget
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+	}
+}
+
+func publicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast()
+}
+
+// publicURL tells if u is an http(s) url whose host isn't a private
+// address or localhost. Names are checked again when dialed.
+func publicURL(u string) bool {
+	parsed, err := url.Parse(u)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return false
+	}
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return publicIP(ip)
+	}
+	return true
+}
+
+// smtpSink mails a notification to the email of the user
+type smtpSink struct {
+	addr string
+	from string
+	auth smtp.Auth
+	// sendMail is smtp.SendMail, tests swap it
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func newSMTPSink(conf *notifyConfig) *smtpSink {
+	s := &smtpSink{addr: conf.SMTP.Addr, from: conf.SMTP.From, sendMail: smtp.SendMail}
+	if conf.SMTP.Username != "" {
+		host, _, _ := net.SplitHostPort(conf.SMTP.Addr)
+		s.auth = smtp.PlainAuth("", conf.SMTP.Username, conf.SMTP.Password, host)
+	}
+	return s
+}
+
+func (s *smtpSink) send(ctx context.Context, user *geoUser, note *notification) error {
+	if user.Email == "" {
+		return &permanentError{errors.New("user has no email")}
+	}
+	msg := strings.Join([]string{
+		"From: " + s.from,
+		"To: " + user.Email,
+		"Subject: geoloc " + strings.ReplaceAll(note.Kind, "_", " "),
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		note.Text,
+		"",
+	}, "\r\n")
+	return s.sendMail(s.addr, s.auth, s.from, []string{user.Email}, []byte(msg))
+}
+
+// ========== notifications api
+
+// getNotifications is the outbox of a user, only for it and staff
+func getNotifications(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		var req reqNotifications
+		err = c.ShouldBindQuery(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		if v := viewerFrom(c.Request.Context()); v.User != id && !v.staff() {
+			abortError(c, errForbidden("not_yours", "only the user sees its notifications"))
+			return
+		}
+
+		notes, err := mongo.getNotifications(c.Request.Context(), id, &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get notifications complete", "body": notes})
+	}
+}
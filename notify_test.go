@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestNotifyQuietHours(t *testing.T) {
+	at := func(s string) time.Time {
+		tm, _ := time.Parse(time.RFC3339, s)
+		return tm
+	}
+	night := &quietHours{Start: "22:00", End: "07:00"}
+	for _, c := range []struct {
+		q     *quietHours
+		now   string
+		quiet bool
+		until string
+	}{
+		{nil, "2026-01-01T23:00:00Z", false, ""},
+		{night, "2026-01-01T21:59:00Z", false, ""},
+		{night, "2026-01-01T23:30:00Z", true, "2026-01-02T07:00:00Z"},
+		{night, "2026-01-02T03:00:00Z", true, "2026-01-02T07:00:00Z"},
+		{night, "2026-01-02T07:00:00Z", false, ""},
+		{&quietHours{Start: "12:00", End: "13:00"}, "2026-01-01T12:15:00Z", true, "2026-01-01T13:00:00Z"},
+		{&quietHours{Start: "12:00", End: "12:00"}, "2026-01-01T12:00:00Z", false, ""},
+		// 22:00 in Moscow is 19:00 UTC
+		{&quietHours{Start: "22:00", End: "07:00", TZ: "Europe/Moscow"}, "2026-01-01T19:30:00Z", true, "2026-01-02T04:00:00Z"},
+	} {
+		until, quiet := c.q.until(at(c.now))
+		assert.Equal(t, c.quiet, quiet, "%+v at %s", c.q, c.now)
+		if c.quiet {
+			assert.True(t, until.Equal(at(c.until)), "%+v at %s until %s", c.q, c.now, until)
+		}
+	}
+
+	// case preferences are validated
+	setupValidation(false)
+	bad := geoUser{Notify: &notifyPrefs{Channels: []string{"pigeon"},
+		Quiet: &quietHours{Start: "25:00", End: "07:00", TZ: "Mars/Olympus"}}}
+	err := validate(&bad)
+	assert.Error(t, err)
+	for _, f := range []string{"channels[0]", "start", "tz"} {
+		assert.Contains(t, strings.ToLower(err.Error()), f)
+	}
+	assert.NoError(t, validate(&geoUser{Notify: &notifyPrefs{Events: true, Radius: 500,
+		Channels: []string{"webhook"}, Webhook: "https://example.com/hook",
+		Quiet: &quietHours{Start: "22:00", End: "07:00", TZ: "Europe/Berlin"}}}))
+
+	// case webhooks on the network of the server are refused
+	for _, hook := range []string{"http://127.0.0.1:8080/hook", "http://localhost/hook",
+		"http://[::1]/hook", "http://169.254.169.254/latest", "http://10.0.0.8/hook", "ftp://example.com/hook"} {
+		err := validate(&geoUser{Notify: &notifyPrefs{Webhook: hook}})
+		assert.Error(t, err, hook)
+	}
+}
+
+func TestNotifyBackoff(t *testing.T) {
+	min, max := time.Second, time.Minute
+	for attempt, want := range map[int]time.Duration{1: time.Second, 3: 4 * time.Second, 7: max, 100: max} {
+		for i := 0; i < 20; i++ {
+			d := backoff(min, max, attempt)
+			assert.True(t, d >= want/2 && d <= want, "attempt %d: %s", attempt, d)
+		}
+	}
+}
+
+func TestNotifySinks(t *testing.T) {
+	user := &geoUser{ID: bson.NewObjectId(), Email: "a@example.com", Notify: &notifyPrefs{}}
+	note := &notification{ID: bson.NewObjectId(), User: user.ID, Kind: "event_nearby", Text: "new event \"x\" 300 m away"}
+	ctx := context.Background()
+	var perm *permanentError
+
+	// case webhook, 5xx is retried and 4xx is not
+	{
+		status := http.StatusOK
+		var got notification
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, note.ID.Hex(), r.Header.Get("X-Geoloc-Notification"))
+			body, _ := io.ReadAll(r.Body)
+			ts := strings.TrimPrefix(strings.Split(r.Header.Get("X-Geoloc-Signature"), ",")[0], "t=")
+			unix, _ := strconv.ParseInt(ts, 10, 64)
+			assert.Equal(t, signHook("whsec_test", time.Unix(unix, 0), body), r.Header.Get("X-Geoloc-Signature"))
+			json.Unmarshal(body, &got)
+			w.WriteHeader(status)
+		}))
+		defer srv.Close()
+		// the test server is on loopback, which publicClient refuses
+		public := webhookSink{client: publicClient(time.Second), secret: "whsec_test"}
+		user.Notify.Webhook = srv.URL
+		assert.True(t, errors.As(public.send(ctx, user, note), &perm), "loopback webhook")
+		user.Notify.Webhook = ""
+		w := webhookSink{client: srv.Client(), secret: "whsec_test"}
+
+		assert.True(t, errors.As(w.send(ctx, user, note), &perm), "no webhook")
+		user.Notify.Webhook = srv.URL
+		assert.NoError(t, w.send(ctx, user, note))
+		assert.Equal(t, note.Text, got.Text)
+		status = http.StatusBadGateway
+		err := w.send(ctx, user, note)
+		assert.Error(t, err)
+		assert.False(t, errors.As(err, &perm))
+		status = http.StatusGone
+		assert.True(t, errors.As(w.send(ctx, user, note), &perm))
+	}
+	// case file, one json line a note
+	{
+		path := filepath.Join(t.TempDir(), "notes.jsonl")
+		f := &fileSink{path: path}
+		assert.NoError(t, f.send(ctx, user, note))
+		assert.NoError(t, f.send(ctx, user, note))
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 2)
+	}
+	// case smtp
+	{
+		conf := defaultConfig().Notify
+		conf.SMTP = smtpConfig{Addr: "mail.example.com:587", From: "geoloc@example.com", Username: "u", Password: "p"}
+		s := newSMTPSink(&conf)
+		var to []string
+		var msg string
+		s.sendMail = func(addr string, a smtp.Auth, from string, rcpt []string, body []byte) error {
+			assert.Equal(t, conf.SMTP.Addr, addr)
+			assert.NotNil(t, a)
+			to, msg = rcpt, string(body)
+			return nil
+		}
+		assert.NoError(t, s.send(ctx, user, note))
+		assert.Equal(t, []string{user.Email}, to)
+		assert.Contains(t, msg, "Subject: geoloc event nearby\r\n")
+		assert.Contains(t, msg, note.Text)
+		assert.True(t, errors.As(s.send(ctx, &geoUser{}, note), &perm), "no email")
+	}
+}
+
+func TestNotifyOutbox(t *testing.T) {
+	conf := defaultConfig().Notify
+	conf.Channels = []string{"log", "webhook"}
+	n := newNotifier(&mongoDB{}, &conf)
+	user := &geoUser{ID: bson.NewObjectId(), Notify: &notifyPrefs{}}
+	subject := bson.NewObjectId()
+
+	// case the server channels by default, only the ones that are on
+	notes := n.outbox(user, "event_nearby", subject, "k", "text", geoObject{}, 10)
+	assert.Len(t, notes, 2)
+	assert.Equal(t, "k:log", notes[0].Key)
+	assert.Equal(t, "pending", notes[1].Status)
+	user.Notify.Channels = []string{"webhook", "smtp"}
+	notes = n.outbox(user, "event_nearby", subject, "k", "text", geoObject{}, 10)
+	assert.Len(t, notes, 1)
+	assert.Equal(t, "webhook", notes[0].Channel)
+
+	// case only new events and moved user locations are news
+	ctx := context.Background()
+	assert.NoError(t, n.match(ctx, change{Kind: "location", Action: "create", After: bson.M{"tobject": "Event"}}))
+	assert.NoError(t, n.match(ctx, change{Kind: "event", Action: "update", After: bson.M{}}))
+	assert.NoError(t, n.match(ctx, change{Kind: "location", Action: "hide", After: bson.M{"tobject": "User"}}))
+	assert.NoError(t, n.match(ctx, change{Kind: "user", Action: "create", After: bson.M{}}))
+
+	assert.Equal(t, "350 m", meters(349.6))
+	assert.Equal(t, "1.2 km", meters(1234))
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// ========== openapi
+
+// openapi.yaml describes the /api/v1 routes of router(), geolocclient is
+// generated from it
+//
+//go:embed openapi.yaml
+var openapiYAML []byte
+
+// loadOpenAPI parses and validates openapi.yaml
+func loadOpenAPI() (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(openapiYAML)
+	if err != nil {
+		return nil, err
+	}
+	return doc, doc.Validate(context.Background())
+}
+
+// getOpenAPI serves openapi.yaml as json, a broken document is a bug so it
+// panics at startup like the graphql schema does
+func getOpenAPI() gin.HandlerFunc {
+	doc, err := loadOpenAPI()
+	if err != nil {
+		panic("openapi.yaml: " + err.Error())
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		panic("openapi.yaml: " + err.Error())
+	}
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", data)
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestOpenAPI fails when openapi.yaml and the /api/v1 routes of router()
+// drift apart, in either direction
+func TestOpenAPI(t *testing.T) {
+	doc, err := loadOpenAPI()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	spec := []string{}
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			spec = append(spec, method+" /api/v1"+path)
+		}
+	}
+
+	conf := defaultConfig()
+	testRouter := newTestRouter(conf, &mongoDB{})
+	routes := []string{}
+	for _, route := range testRouter.Routes() {
+		if !strings.HasPrefix(route.Path, "/api/v1/") {
+			continue
+		}
+		parts := strings.Split(route.Path, "/")
+		for i, part := range parts {
+			if strings.HasPrefix(part, ":") {
+				parts[i] = "{" + part[1:] + "}"
+			}
+		}
+		routes = append(routes, route.Method+" "+strings.Join(parts, "/"))
+	}
+
+	sort.Strings(spec)
+	sort.Strings(routes)
+	assert.Equal(t, routes, spec, "openapi.yaml and router() differ")
+
+	for path, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			name := method + " " + path + " " + op.OperationID
+			params := append(openapi3.Parameters{}, item.Parameters...)
+			params = append(params, op.Parameters...)
+
+			// the path parameters are the ones of the route
+			inPath, query := []string{}, []string{}
+			for _, p := range params {
+				switch p.Value.In {
+				case openapi3.ParameterInPath:
+					inPath = append(inPath, "{"+p.Value.Name+"}")
+				case openapi3.ParameterInQuery:
+					query = append(query, p.Value.Name)
+				}
+			}
+			wantPath := []string{}
+			for _, part := range strings.Split(path, "/") {
+				if strings.HasPrefix(part, "{") {
+					wantPath = append(wantPath, part)
+				}
+			}
+			assert.ElementsMatch(t, wantPath, inPath, "path parameters of %s", name)
+
+			// the query parameters are bound by the handler's model
+			if q, ok := openapiQueries[op.OperationID]; ok || len(query) > 0 {
+				if assert.True(t, ok, "no query model for %s", name) {
+					bound := members(reflect.TypeOf(q.model), "form")
+					if q.exact {
+						assert.ElementsMatch(t, bound, query, "query parameters of %s", name)
+					} else {
+						assert.Subset(t, bound, query, "query parameters of %s", name)
+					}
+				}
+			}
+
+			// the body of a 2xx answer is the model it is built from
+			for code, res := range op.Responses.Map() {
+				if !strings.HasPrefix(code, "2") || res.Value.Content["application/json"] == nil {
+					continue
+				}
+				body := res.Value.Content["application/json"].Schema.Value.Properties["body"]
+				if body == nil {
+					continue
+				}
+				if body.Value.Items != nil {
+					body = body.Value.Items
+				}
+				schema := strings.TrimPrefix(body.Ref, "#/components/schemas/")
+				model, ok := openapiModels[schema]
+				if !assert.True(t, ok, "no model for the %s body %q of %s", code, schema, name) || model == nil {
+					continue
+				}
+				assert.ElementsMatch(t, members(reflect.TypeOf(model), "json"), properties(body.Value),
+					"members of %s and %T", schema, model)
+			}
+		}
+	}
+
+	response := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/openapi.json", nil)
+	testRouter.ServeHTTP(response, req)
+	assert.Equal(t, http.StatusOK, response.Code)
+	served := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &served))
+	assert.Equal(t, "3.0.3", served["openapi"])
+}
+
+// openapiQueries are the models the handlers bind the query of an
+// operation to. exact is false when the model also binds a body and only
+// some of its members are query parameters.
+var openapiQueries = map[string]struct {
+	model interface{}
+	exact bool
+}{
+	"getUserByEmail":    {legacyUser{}, false},
+	"getEventLegacy":    {legacyEvent{}, false},
+	"getLocLegacy":      {geoLocation{}, false},
+	"getFollowers":      {reqRelations{}, true},
+	"getFollowing":      {reqRelations{}, true},
+	"getFriends":        {reqRelations{}, true},
+	"getFriendRequests": {reqRelations{}, true},
+	"getNotifications":  {reqNotifications{}, true},
+	"getNearLoc":        {reqNear{}, true},
+	"getFriendsNear":    {reqNear{}, false},
+	"getFiltered":       {reqFilter{}, true},
+	"getQueue":          {reqQueue{}, true},
+	"getDeliveries":     {reqDeliveries{}, true},
+	"postReplay":        {reqReplay{}, true},
+	"getAudit":          {reqAudit{}, true},
+}
+
+// openapiModels are the models behind the schemas of 2xx bodies, nil for a
+// body the handler builds in place
+var openapiModels = map[string]interface{}{
+	"":               nil,
+	"User":           geoUser{},
+	"LegacyUser":     legacyUser{},
+	"Event":          geoEvent{},
+	"LegacyEvent":    legacyEvent{},
+	"Location":       geoLocation{},
+	"EventLoc":       eventLoc{},
+	"IDResponse":     respondID{},
+	"BulkItemResult": bulkItemResult{},
+	"IngestResult":   nil,
+	"Report":         report{},
+	"Relation":       relation{},
+	"Notification":   notification{},
+	"Key":            apiKey{},
+	"KeySecret":      apiKeySecret{},
+	"Webhook":        webhook{},
+	"WebhookSecret":  webhookSecret{},
+	"Delivery":       delivery{},
+	"AuditEntry":     auditEntry{},
+}
+
+// members are the names t has under tag, with the ones of embedded structs
+func members(t reflect.Type, tag string) (names []string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get(tag), ",")[0]
+		if f.Anonymous && name == "" {
+			names = append(names, members(f.Type, tag)...)
+			continue
+		}
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// properties are the members schema declares, with the ones of its allOf
+func properties(schema *openapi3.Schema) (names []string) {
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	for _, s := range schema.AllOf {
+		names = append(names, properties(s.Value)...)
+	}
+	return names
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== resource routes
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// pathID parses the :id route param as an ObjectId
+func pathID(c *gin.Context) (id bson.ObjectId, err error) {
+	return parseID(c.Param("id"))
+}
+
+// parseID is pathID for ids that come from elsewhere, like grpc requests
+func parseID(hex string) (id bson.ObjectId, err error) {
+	if !bson.IsObjectIdHex(hex) {
+		ae := newAPIError(kindValidation, "invalid_id", "id must be a 24 char hex ObjectId", nil)
+		ae.Fields = []fieldError{{Field: "id", Rule: "objectid", Message: "must be a 24 char hex ObjectId"}}
+		return id, ae
+	}
+	return bson.ObjectIdHex(hex), nil
+}
+
+// deprecated marks a legacy route and points clients at its successor
+func deprecated(successor string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Deprecation", "true")
+		c.Header("Link", "<"+successor+">; rel=\"successor-version\"")
+		c.Next()
+	}
+}
+
+// bindMergePatch applies the RFC 7396 JSON Merge Patch in the request body
+// to doc and validates the result
+func bindMergePatch(c *gin.Context, doc interface{}) error {
+	ct := c.ContentType()
+	if ct != mergePatchContentType && ct != binding.MIMEJSON {
+		return errValidation("unsupported_media_type",
+			errors.New("content type must be "+mergePatchContentType))
+	}
+	patch, err := c.GetRawData()
+	if err != nil {
+		return errValidation("invalid_request", err)
+	}
+	orig, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	merged, err := mergePatch(orig, patch)
+	if err != nil {
+		return errValidation("invalid_patch", err)
+	}
+
+	// start from zero so removed members don't keep their old values
+	val := reflect.ValueOf(doc).Elem()
+	stored := reflect.New(val.Type()).Elem()
+	stored.Set(val)
+	val.Set(reflect.Zero(val.Type()))
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	if binding.EnableDecoderDisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	err = decoder.Decode(doc)
+	if err != nil {
+		return errValidation("invalid_patch", err)
+	}
+	var members map[string]json.RawMessage
+	err = json.Unmarshal(patch, &members)
+	if err != nil {
+		return errValidation("invalid_patch", err)
+	}
+	keepRefs(val, stored, members)
+	err = binding.Validator.ValidateStruct(doc)
+	if err != nil {
+		return errValidation("invalid_request", err)
+	}
+	return nil
+}
+
+// refsType is the links between documents, their ObjectId ids come back
+// from json as plain strings
+var refsType = reflect.TypeOf([]mgo.DBRef(nil))
+
+// keepRefs sets the refs members of doc the patch leaves alone back to the
+// stored ones, so a patch doesn't turn every stored ObjectId into a string
+func keepRefs(doc, stored reflect.Value, patched map[string]json.RawMessage) {
+	t := doc.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Type != refsType {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" {
+			name = f.Name
+		}
+		touched := false
+		for key := range patched {
+			// members match case-insensitively, as in mergeValue
+			touched = touched || strings.EqualFold(key, name)
+		}
+		if !touched {
+			doc.Field(i).Set(stored.Field(i))
+		}
+	}
+}
+
+// mergePatch implements RFC 7396 on raw json documents
+func mergePatch(doc, patch []byte) ([]byte, error) {
+	var target, p interface{}
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, err
+	}
+	if _, ok := p.(map[string]interface{}); !ok {
+		return nil, errors.New("merge patch must be a json object")
+	}
+	return json.Marshal(mergeValue(target, p))
+}
+
+func mergeValue(target, patch interface{}) interface{} {
+	pm, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	tm, ok := target.(map[string]interface{})
+	if !ok {
+		tm = map[string]interface{}{}
+	}
+	for key, value := range pm {
+		// members match case-insensitively, as encoding/json does on decode
+		for tkey := range tm {
+			if tkey != key && strings.EqualFold(tkey, key) {
+				tm[key] = tm[tkey]
+				delete(tm, tkey)
+			}
+		}
+		if value == nil {
+			delete(tm, key)
+		} else {
+			tm[key] = mergeValue(tm[key], value)
+		}
+	}
+	return tm
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestMergePatch(t *testing.T) {
+	cases := []struct {
+		doc, patch, want string
+	}{
+		// RFC 7396 appendix A
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		// omitted members are kept
+		{`{"name":"jhon","tags":["x"],"email":"a@b.c"}`, `{"name":"bob"}`,
+			`{"email":"a@b.c","name":"bob","tags":["x"]}`},
+	}
+	for _, cs := range cases {
+		got, err := mergePatch([]byte(cs.doc), []byte(cs.patch))
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, cs.want, string(got), cs.patch)
+		}
+	}
+
+	_, err := mergePatch([]byte(`{}`), []byte(`["a"]`))
+	assert.Error(t, err, "non object patch should fail")
+}
+
+func TestBindMergePatch(t *testing.T) {
+	setupValidation(false)
+	bind := func(doc *geoEvent, patch string) error {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request, _ = http.NewRequest("PATCH", "/", bytes.NewBufferString(patch))
+		c.Request.Header.Set("Content-Type", mergePatchContentType)
+		return bindMergePatch(c, doc)
+	}
+	user := bson.NewObjectId()
+	doc := geoEvent{ID: bson.NewObjectId(), Name: "party",
+		Users: []mgo.DBRef{{Collection: "dviUsers", Id: user}}}
+
+	// refs the patch leaves alone keep their ObjectIds
+	assert.NoError(t, bind(&doc, `{"name":"rave"}`))
+	assert.Equal(t, "rave", doc.Name)
+	assert.Equal(t, []mgo.DBRef{{Collection: "dviUsers", Id: user}}, doc.Users)
+
+	assert.NoError(t, bind(&doc, `{"users":null}`))
+	assert.Empty(t, doc.Users)
+}
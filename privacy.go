@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"math"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== privacy
+
+var (
+	visibilities = []string{"public", "friends", "hidden"}
+	precisions   = []string{"exact", "grid", "geohash"}
+)
+
+// earthRadius is the sphere of $nearSphere, in meters
+const earthRadius = 6378100.0
+
+// degree is the length of a degree of latitude, in meters
+var degree = earthRadius * math.Pi / 180
+
+// viewer is who a location is served to, the owner of the request's key
+type viewer struct {
+	User bson.ObjectId
+	Role string
+}
+
+type viewerKey struct{}
+
+func withViewer(ctx context.Context, v viewer) context.Context {
+	return context.WithValue(ctx, viewerKey{}, v)
+}
+
+// viewerFrom is the viewer of ctx, without one it is anonymous
+func viewerFrom(ctx context.Context) viewer {
+	v, _ := ctx.Value(viewerKey{}).(viewer)
+	return v
+}
+
+// staff sees every location as it is
+func (v viewer) staff() bool {
+	return v.Role == "moderator" || v.Role == "admin"
+}
+
+var errNotOwner = errForbidden("not_yours", "only the user and staff see and change its privacy and notify")
+
+// owns tells if v is user or staff, who see and write its privacy and notify
+func (v viewer) owns(user bson.ObjectId) bool {
+	return v.staff() || (v.User != "" && v.User == user)
+}
+
+// redact drops the members of u that only its owner sees
+func (v viewer) redact(u *geoUser) {
+	if !v.owns(u.ID) {
+		u.Privacy = nil
+		u.Notify = nil
+	}
+}
+
+// middlewareViewer runs after middlewareAPIKey, so the owner is known
+func middlewareViewer() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v := viewer{Role: c.GetString("role")}
+		if uid := c.GetString("user_id"); bson.IsObjectIdHex(uid) {
+			v.User = bson.ObjectIdHex(uid)
+		}
+		c.Request = c.Request.WithContext(withViewer(c.Request.Context(), v))
+		c.Next()
+	}
+}
+
+// settings is the privacy of a user with the defaults filled in
+func (conf *privacyConfig) settings(p *privacy) privacy {
+	s := privacy{Visibility: conf.Visibility, Precision: conf.Precision}
+	if p != nil && p.Visibility != "" {
+		s.Visibility = p.Visibility
+	}
+	if p != nil && p.Precision != "" {
+		s.Precision = p.Precision
+	}
+	return s
+}
+
+// show tells if v may see the location of user, and fuzzed if it may
+// only see the cell around it. friend is if v and user are friends.
+func (v viewer) show(user bson.ObjectId, s privacy, friend bool) (ok, fuzzed bool) {
+	if v.owns(user) {
+		return true, false
+	}
+	if s.Visibility == "hidden" || (s.Visibility == "friends" && !friend) {
+		return false, false
+	}
+	return true, s.Precision != "exact"
+}
+
+// fuzz moves loc to the center of its cell for the precision
+func (conf *privacyConfig) fuzz(precision string, loc geoObject) geoObject {
+	lng, lat := loc.Coordinates[0], loc.Coordinates[1]
+	switch precision {
+	case "grid":
+		lng, lat = snapGrid(lng, lat, conf.GridSize)
+	case "geohash":
+		lng, lat = geohashCenter(lng, lat, conf.GeohashLength)
+	}
+	loc.Coordinates = [2]float64{lng, lat}
+	return loc
+}
+
+// margin is how far, in meters, a fuzzed location may be from the real
+// one. Queries widen by it and then keep fuzzed locations by where they
+// are served, so the edge of a search does not give the real one away.
+func (conf *privacyConfig) margin() float64 {
+	grid := conf.GridSize * math.Sqrt2 / 2
+	lngDeg, latDeg := geohashCell(conf.GeohashLength)
+	geohash := math.Hypot(lngDeg*degree, latDeg*degree) / 2
+	// cells are narrower away from the equator than at their center
+	return math.Max(grid, geohash) * 1.1
+}
+
+// snapGrid is the center of the cell of about size meters that holds
+// lng, lat. Rows are size high and every row is cut in cells size wide.
+func snapGrid(lng, lat, size float64) (float64, float64) {
+	dlat := size / degree
+	lat = math.Min((math.Floor((lat+90)/dlat)+0.5)*dlat-90, 90)
+	dlng := 360.0
+	if c := math.Cos(lat * math.Pi / 180); c > 0 {
+		dlng = math.Min(size/(degree*c), 360)
+	}
+	lng = math.Min((math.Floor((lng+180)/dlng)+0.5)*dlng-180, 180)
+	return lng, lat
+}
+
+// geohashCell is the size in degrees of a geohash cell of length chars
+func geohashCell(length int) (lngDeg, latDeg float64) {
+	bits := 5 * length
+	lngBits, latBits := (bits+1)/2, bits/2
+	return 360 / math.Exp2(float64(lngBits)), 180 / math.Exp2(float64(latBits))
+}
+
+// geohashCenter is the center of the geohash cell of length chars that
+// holds lng, lat, bits alternate between longitude and latitude
+func geohashCenter(lng, lat float64, length int) (float64, float64) {
+	lngMin, lngMax, latMin, latMax := -180.0, 180.0, -90.0, 90.0
+	for bit := 0; bit < 5*length; bit++ {
+		if bit%2 == 0 {
+			mid := (lngMin + lngMax) / 2
+			if lng >= mid {
+				lngMin = mid
+			} else {
+				lngMax = mid
+			}
+		} else {
+			mid := (latMin + latMax) / 2
+			if lat >= mid {
+				latMin = mid
+			} else {
+				latMax = mid
+			}
+		}
+	}
+	return (lngMin + lngMax) / 2, (latMin + latMax) / 2
+}
+
+// sphereDistance is between two points on the sphere of $nearSphere, in meters
+func sphereDistance(lng1, lat1, lng2, lat2 float64) float64 {
+	rad := math.Pi / 180
+	dlat, dlng := (lat2-lat1)*rad, (lng2-lng1)*rad
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dlng/2)*math.Sin(dlng/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// widen grows box by meters on every side, within the world
+func (box reqBBox) widen(meters float64) reqBBox {
+	dlat := meters / degree
+	box.MinLat, box.MaxLat = math.Max(box.MinLat-dlat, -90), math.Min(box.MaxLat+dlat, 90)
+	dlng := 360.0
+	edge := math.Max(math.Abs(box.MinLat), math.Abs(box.MaxLat))
+	if c := math.Cos(edge * math.Pi / 180); c > 0 {
+		dlng = meters / (degree * c)
+	}
+	box.MinLng, box.MaxLng = math.Max(box.MinLng-dlng, -180), math.Min(box.MaxLng+dlng, 180)
+	return box
+}
+
+func (box reqBBox) holds(loc geoObject) bool {
+	lng, lat := loc.Coordinates[0], loc.Coordinates[1]
+	return lng >= box.MinLng && lng <= box.MaxLng && lat >= box.MinLat && lat <= box.MaxLat
+}
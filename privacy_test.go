@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestPrivacy(t *testing.T) {
+	conf := defaultConfig().Mongo.Privacy
+	owner := bson.NewObjectId()
+
+	// case defaults and overrides
+	assert.Equal(t, privacy{"public", "exact"}, conf.settings(nil))
+	assert.Equal(t, privacy{"hidden", "exact"}, conf.settings(&privacy{Visibility: "hidden"}))
+
+	// case who sees what
+	for _, c := range []struct {
+		v            viewer
+		s            privacy
+		friend       bool
+		show, fuzzed bool
+	}{
+		{viewer{}, privacy{"public", "exact"}, false, true, false},
+		{viewer{}, privacy{"public", "grid"}, false, true, true},
+		{viewer{}, privacy{"friends", "exact"}, false, false, false},
+		{viewer{}, privacy{"hidden", "exact"}, false, false, false},
+		{viewer{User: bson.NewObjectId()}, privacy{"friends", "grid"}, true, true, true},
+		{viewer{User: bson.NewObjectId()}, privacy{"hidden", "exact"}, true, false, false},
+		{viewer{User: owner}, privacy{"hidden", "grid"}, false, true, false},
+		{viewer{Role: "moderator"}, privacy{"friends", "geohash"}, false, true, false},
+	} {
+		show, fuzzed := c.v.show(owner, c.s, c.friend)
+		assert.Equal(t, c.show, show, "%+v %+v", c.v, c.s)
+		assert.Equal(t, c.fuzzed, fuzzed, "%+v %+v", c.v, c.s)
+	}
+
+	// case privacy and notify are the owner's and staff's alone
+	{
+		user := geoUser{ID: owner, Privacy: &privacy{Visibility: "hidden"}, Notify: &notifyPrefs{Events: true}}
+		seen := user
+		viewer{User: owner}.redact(&seen)
+		assert.Equal(t, user, seen)
+		viewer{Role: "admin"}.redact(&seen)
+		assert.Equal(t, user, seen)
+		viewer{User: bson.NewObjectId()}.redact(&seen)
+		assert.Nil(t, seen.Privacy)
+		assert.Nil(t, seen.Notify)
+		assert.False(t, viewer{}.owns(owner))
+	}
+
+	// case a fuzzed location stays within the margin and nearby ones share it
+	for _, p := range [][2]float64{{37.6173, 55.7558}, {-0.1278, 51.5074}, {151.2093, -33.8688}, {0, 0}, {179.999, 84.9}} {
+		loc := geoObject{Type: "Point", Coordinates: p}
+		for _, precision := range []string{"grid", "geohash"} {
+			fuzzed := conf.fuzz(precision, loc)
+			assert.NotEqual(t, loc.Coordinates, fuzzed.Coordinates, precision)
+			d := sphereDistance(p[0], p[1], fuzzed.Coordinates[0], fuzzed.Coordinates[1])
+			assert.True(t, d <= conf.margin(), "%s %v is %.0fm away", precision, p, d)
+			again := conf.fuzz(precision, fuzzed)
+			assert.InDelta(t, fuzzed.Coordinates[0], again.Coordinates[0], 1e-9, precision)
+			assert.InDelta(t, fuzzed.Coordinates[1], again.Coordinates[1], 1e-9, precision)
+		}
+	}
+	assert.Equal(t, lngLat(1, 2), conf.fuzz("exact", lngLat(1, 2)))
+
+	// case geohash cells, length 6 is about 1.2km x 0.6km
+	lngDeg, latDeg := geohashCell(6)
+	assert.InDelta(t, 1223, lngDeg*degree, 5)
+	assert.InDelta(t, 611, latDeg*degree, 5)
+	lng, lat := geohashCenter(10.40744, 57.64911, 11)
+	assert.InDelta(t, 10.40744, lng, 1e-5)
+	assert.InDelta(t, 57.64911, lat, 1e-5)
+
+	// case a widened box holds every fuzzed location of the box
+	box := reqBBox{MinLng: 37, MinLat: 55, MaxLng: 38, MaxLat: 56, Limit: 10}
+	wide := box.widen(conf.margin())
+	assert.True(t, wide.MinLat < box.MinLat && wide.MaxLng > box.MaxLng)
+	assert.True(t, wide.holds(conf.fuzz("grid", lngLat(37.0001, 55.0001))))
+	assert.False(t, box.holds(lngLat(36.99, 55.5)))
+	assert.Equal(t, -180.0, reqBBox{MinLng: -180, MaxLng: 180, MinLat: -90, MaxLat: 90}.widen(1e6).MinLng)
+
+	// case the viewer of a request without one is anonymous
+	assert.Equal(t, viewer{}, viewerFrom(context.Background()))
+	assert.Equal(t, []int{1, 3}, kept([]int{1, 2, 3}, []bool{true, false, true}))
+	assert.True(t, math.Abs(sphereDistance(0, 0, 0, 1)-degree) < 1)
+}
+
+func lngLat(lng, lat float64) geoObject {
+	return geoObject{Type: "Point", Coordinates: [2]float64{lng, lat}}
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gomodule/redigo/redis"
+)
+
+// ========== rate limit
+
+const apiKeyHeader = "X-API-Key"
+
+var limitBackends = []string{"memory", "redis"}
+
+var errRateLimited = newAPIError(kindTooManyRequests, "rate_limited",
+	"too many requests, retry after the Retry-After seconds", nil)
+
+// limitResult is the state of a bucket after one take
+type limitResult struct {
+	Allowed bool
+	// Tokens left, fractional while refilling
+	Tokens float64
+}
+
+// limitStore keeps token buckets, memoryStore for one instance and
+// redisStore to share the budgets between instances
+type limitStore interface {
+	take(ctx context.Context, key string, budget limitBudget, now time.Time) (limitResult, error)
+}
+
+func newLimitStore(conf *rateLimitConfig) limitStore {
+	if conf.Backend == "redis" {
+		return newRedisStore(conf.RedisURL)
+	}
+	return newMemoryStore()
+}
+
+// refill is the token bucket arithmetic shared by the stores
+func refill(tokens float64, elapsed time.Duration, budget limitBudget) float64 {
+	return math.Min(float64(budget.Burst), tokens+elapsed.Seconds()*budget.Rate)
+}
+
+// ========== memory store
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// full is when the bucket has refilled to its burst
+	full time.Time
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{buckets: map[string]*bucket{}}
+}
+
+func (s *memoryStore) take(ctx context.Context, key string, budget limitBudget, now time.Time) (limitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(budget.Burst), last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = refill(b.tokens, now.Sub(b.last), budget)
+	b.last = now
+
+	res := limitResult{}
+	if b.tokens >= 1 {
+		b.tokens--
+		res.Allowed = true
+	}
+	res.Tokens = b.tokens
+	b.full = now.Add(time.Duration((float64(budget.Burst) - b.tokens) / budget.Rate * float64(time.Second)))
+	return res, nil
+}
+
+// sweep drops the buckets that have refilled, a full bucket is the same as
+// none. Slow budgets keep theirs until then, or a sweep would grant a burst.
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// ========== redis store
+
+// takeScript refills and takes from the bucket hash at KEYS[1] atomically,
+// ARGV is rate per second, burst and now in unix milliseconds
+var takeScript = redis.NewScript(1, `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local b = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(b[1])
+local ts = tonumber(b[2])
+if tokens == nil then
+  tokens = burst
+  ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+local allowed = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+return {allowed, tostring(tokens)}
+`)
+
+type redisStore struct {
+	pool *redis.Pool
+}
+
+func newRedisStore(url string) *redisStore {
+	return &redisStore{pool: &redis.Pool{
+		MaxIdle:     16,
+		IdleTimeout: 4 * time.Minute,
+		Dial: func() (redis.Conn, error) {
+			return redis.DialURL(url,
+				redis.DialConnectTimeout(time.Second),
+				redis.DialReadTimeout(time.Second),
+				redis.DialWriteTimeout(time.Second))
+		},
+	}}
+}
+
+func (s *redisStore) take(ctx context.Context, key string, budget limitBudget, now time.Time) (res limitResult, err error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	reply, err := redis.Values(takeScript.Do(conn, "geoloc:rl:"+key,
+		budget.Rate, budget.Burst, now.UnixNano()/int64(time.Millisecond)))
+	if err != nil {
+		return res, err
+	}
+	var allowed int
+	var tokens string
+	_, err = redis.Scan(reply, &allowed, &tokens)
+	if err != nil {
+		return res, err
+	}
+	res.Allowed = allowed == 1
+	res.Tokens, err = strconv.ParseFloat(tokens, 64)
+	return res, err
+}
+
+// ========== rate limit middleware
+
+// limitClass picks the budget of a request, expensive routes first
+func limitClass(conf *rateLimitConfig, c *gin.Context) (string, limitBudget) {
+	switch {
+	case contains(conf.ExpensiveRoutes, c.FullPath()):
+		return "expensive", conf.Expensive
+	case c.Request.Method == "GET" || c.Request.Method == "HEAD":
+		return "read", conf.Read
+	}
+	return "write", conf.Write
+}
+
+// limitIdentity is who the budget belongs to: the owner of a validated api
+// key, else the key, else the client ip. Requests whose key fails are
+// charged to their ip by middlewareAPIKey, before it answers them.
+func limitIdentity(keyID, userID, ip string) string {
+	if userID != "" {
+		return "user:" + userID
+	}
+	if keyID != "" {
+		return "key:" + keyID
+	}
+	return "ip:" + ip
+}
+
+// middlewareRateLimit takes a token per request and answers 429 when the
+// bucket is empty. A failing store lets requests through, an outage of the
+// shared backend shouldn't take the api down with it.
+func middlewareRateLimit(conf *rateLimitConfig, store limitStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		who := limitIdentity(c.GetString("key_id"), c.GetString("user_id"), c.ClientIP())
+		if limitRequest(c, conf, store, who) {
+			c.Next()
+		}
+	}
+}
+
+// limitRequest takes a token of who for the request and sets the RateLimit-*
+// headers, it aborts with 429 and returns false when the bucket is empty
+func limitRequest(c *gin.Context, conf *rateLimitConfig, store limitStore, who string) bool {
+	if !conf.Enabled || c.Request.Method == "OPTIONS" {
+		return true
+	}
+
+	class, budget := limitClass(conf, c)
+	headers, err := takeLimit(c.Request.Context(), store, class, budget, who)
+	if err != nil && err != errRateLimited {
+		requestLogger(c).Warn("rate limit store", "error", err.Error())
+		return true
+	}
+	for name, value := range headers {
+		c.Header(name, value)
+	}
+	if err != nil {
+		abortError(c, err)
+		return false
+	}
+	return true
+}
+
+// takeLimit takes a token of the class bucket of who and returns the
+// RateLimit-* headers to answer with, and errRateLimited with a Retry-After
+// header when it is empty. It is shared by the REST and grpc apis.
+func takeLimit(ctx context.Context, store limitStore, class string, budget limitBudget, who string) (headers map[string]string, err error) {
+	res, err := store.take(ctx, class+":"+who, budget, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	secs := func(tokens float64) string {
+		return strconv.Itoa(int(math.Ceil(tokens / budget.Rate)))
+	}
+	headers = map[string]string{
+		"RateLimit-Policy":    strconv.Itoa(budget.Burst) + ";w=" + secs(float64(budget.Burst)),
+		"RateLimit-Limit":     strconv.Itoa(budget.Burst),
+		"RateLimit-Remaining": strconv.Itoa(int(res.Tokens)),
+		"RateLimit-Reset":     secs(float64(budget.Burst) - res.Tokens),
+	}
+	if !res.Allowed {
+		metrics.rateLimited.WithLabelValues(class).Inc()
+		headers["Retry-After"] = secs(1 - res.Tokens)
+		return headers, errRateLimited
+	}
+	return headers, nil
+}
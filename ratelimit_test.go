@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	mgo "gopkg.in/mgo.v2"
+)
+
+func TestRateLimitBucket(t *testing.T) {
+	store := newMemoryStore()
+	budget := limitBudget{Rate: 2, Burst: 3}
+	now := time.Now()
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, _ := store.take(ctx, "k", budget, now)
+		assert.True(t, res.Allowed, "take %d", i)
+	}
+	res, _ := store.take(ctx, "k", budget, now)
+	assert.False(t, res.Allowed)
+
+	// 2 tokens a second, half a second refills one
+	res, _ = store.take(ctx, "k", budget, now.Add(500*time.Millisecond))
+	assert.True(t, res.Allowed)
+	res, _ = store.take(ctx, "other", budget, now)
+	assert.True(t, res.Allowed)
+
+	// a sweep keeps the buckets that are still refilling
+	slow := limitBudget{Rate: 0.005, Burst: 1}
+	res, _ = store.take(ctx, "slow", slow, now)
+	assert.True(t, res.Allowed)
+	later := now.Add(2 * time.Minute)
+	res, _ = store.take(ctx, "slow", slow, later)
+	assert.False(t, res.Allowed, "swept before it refilled")
+	_, ok := store.buckets["k"]
+	assert.False(t, ok, "full bucket not swept")
+}
+
+func TestRateLimit(t *testing.T) {
+	conf := defaultConfig().RateLimit
+	conf.Read = limitBudget{Rate: 1, Burst: 2}
+	conf.Expensive = limitBudget{Rate: 0.5, Burst: 1}
+	conf.ExpensiveRoutes = []string{"/filter"}
+
+	gin.SetMode(gin.ReleaseMode)
+	eng := gin.New()
+	// stands in for middlewareAPIKey, which sets key_id for valid keys only
+	eng.Use(func(c *gin.Context) {
+		if c.GetHeader(apiKeyHeader) == "gk_valid" {
+			c.Set("key_id", "k1")
+		}
+	})
+	eng.Use(middlewareRateLimit(&conf, newMemoryStore()))
+	eng.GET("/all", func(c *gin.Context) { c.Status(http.StatusOK) })
+	eng.GET("/filter", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	get := func(url, key string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", url, nil)
+		if key != "" {
+			req.Header.Set(apiKeyHeader, key)
+		}
+		response := httptest.NewRecorder()
+		eng.ServeHTTP(response, req)
+		return response
+	}
+
+	assert.Equal(t, http.StatusOK, get("/all", "").Code)
+	res := get("/all", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "2", res.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", res.Header().Get("RateLimit-Remaining"))
+
+	res = get("/all", "")
+	assert.Equal(t, http.StatusTooManyRequests, res.Code)
+	assert.Equal(t, "1", res.Header().Get("Retry-After"))
+	assert.Equal(t, problemContentType, res.Header().Get("Content-Type"))
+
+	// valid api keys and expensive routes have their own buckets, made up
+	// keys share the one of the ip
+	assert.Equal(t, http.StatusTooManyRequests, get("/all", "secret").Code)
+	assert.Equal(t, http.StatusTooManyRequests, get("/all", "gk_other").Code)
+	assert.Equal(t, http.StatusOK, get("/all", "gk_valid").Code)
+	assert.Equal(t, http.StatusOK, get("/filter", "").Code)
+	res = get("/filter", "")
+	assert.Equal(t, http.StatusTooManyRequests, res.Code)
+	assert.Equal(t, "2", res.Header().Get("Retry-After"))
+
+	assert.Equal(t, "user:u1", limitIdentity("k1", "u1", "10.0.0.1"))
+	assert.Equal(t, "key:k1", limitIdentity("k1", "", "10.0.0.1"))
+	assert.Equal(t, "ip:10.0.0.1", limitIdentity("", "", "10.0.0.1"))
+}
+
+// the router charges anonymous requests and failed keys to the remote
+// address, whatever X-Forwarded-For says
+func TestRateLimitClients(t *testing.T) {
+	conf := defaultConfig()
+	conf.RateLimit.Write = limitBudget{Rate: 0.1, Burst: 2}
+	db := &mongoDB{}
+	ring := newKeyring(db, &conf.Auth)
+	ring.find = func(ctx context.Context, prefix string) (apiKey, error) {
+		return apiKey{}, mgo.ErrNotFound
+	}
+	eng := router(conf, db, newIngester(db, &conf.Ingest), ring, newLimitStore(&conf.RateLimit))
+	defer setupValidation(false)
+
+	// an invalid body is answered before the db is needed
+	post := func(remote, forwarded, key string) int {
+		req, _ := http.NewRequest("POST", "/api/v1/locs", strings.NewReader("{"))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = remote + ":4000"
+		req.Header.Set("X-Forwarded-For", forwarded)
+		if key != "" {
+			req.Header.Set(apiKeyHeader, key)
+		}
+		response := httptest.NewRecorder()
+		eng.ServeHTTP(response, req)
+		return response.Code
+	}
+
+	// case a new X-Forwarded-For each request is still one client
+	{
+		assert.Equal(t, http.StatusBadRequest, post("203.0.113.1", "10.0.0.1", ""))
+		assert.Equal(t, http.StatusBadRequest, post("203.0.113.1", "10.0.0.2", ""))
+		assert.Equal(t, http.StatusTooManyRequests, post("203.0.113.1", "10.0.0.3", ""))
+	}
+	// case guessed keys take from the bucket of the ip
+	{
+		for i, want := range []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests} {
+			raw, _, _, err := newKeyMaterial()
+			assert.NoError(t, err)
+			assert.Equal(t, want, post("203.0.113.2", "", raw), "guess %d", i)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, post("203.0.113.2", "", ""))
+	}
+}
@@ -3,55 +3,147 @@ package main
 import (
 	"github.com/gin-gonic/contrib/static"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
-func router(db *mongoDB) *gin.Engine {
-	router := gin.Default()
-	gin.SetMode(gin.DebugMode)
-	router.Use(middlewareDB(db))
+// router serves the REST api, ring and limits are shared with the grpc api
+func router(conf *config, db *mongoDB, ing *ingester, ring *keyring, limits limitStore) *gin.Engine {
+	if conf.LogLevel == "debug" {
+		gin.SetMode(gin.DebugMode)
+	} else {
+		gin.SetMode(gin.ReleaseMode)
+	}
+	setupValidation(conf.StrictJSON)
+
+	router := gin.New()
+	// X-Forwarded-For is only read from these, the list is validated with
+	// the config
+	router.SetTrustedProxies(conf.Server.TrustedProxies)
+	router.Use(otelgin.Middleware(conf.Tracing.ServiceName))
+	router.Use(middlewareLogger())
+	router.Use(gin.CustomRecovery(recoverProblem))
+	router.Use(middlewareMetrics())
 
-	router.Use(static.Serve("/", static.LocalFile(STATIC_FOLDER, false)))
+	router.GET("/healthz", getHealthz())
+	router.GET("/readyz", getReadyz(db))
+	router.GET("/metrics", getMetrics(db))
+	// the spec doesn't need the db, so it is outside the api group
+	cors := middlewareCORS(&conf.CORS)
+	router.GET("/api/v1/openapi.json", cors, getOpenAPI())
+
+	router.Use(static.Serve("/", static.LocalFile(conf.StaticDir, false)))
 
 	api := router.Group("api")
-	api.Use(middlewareCORS())
+	api.Use(cors)
+	// preflights of any api route, answered by the cors middleware
+	api.OPTIONS("/*path")
+	api.Use(middlewareDB(db))
+	// a key that fails is charged to the ip here, the limiter after it
+	// charges valid keys to their own budget
+	api.Use(middlewareAPIKey(&conf.Auth, ring, &conf.RateLimit, limits))
+	api.Use(middlewareRateLimit(&conf.RateLimit, limits))
+	api.Use(middlewareAudit())
+	api.Use(middlewareViewer())
 	{
 		v1 := api.Group("v1")
 		{
+			v1.POST("/graphql", postGraphQL(newGraphQLSchema(db), db))
+
+			admin := v1.Group("admin")
+			{
+				keys := admin.Group("keys", requireRole("admin"))
+				keys.GET("", getKeys(db))
+				keys.POST("", postKey(db))
+				keys.POST("/:id/rotate", postKeyRotate(db, ring))
+				keys.DELETE("/:id", delKey(db, ring))
+
+				admin.PUT("/users/:id/role", requireRole("admin"), putRole(db, ring))
+				admin.GET("/audit", requireRole("admin"), getAudit(db))
+
+				hooks := admin.Group("webhooks", requireRole("admin"))
+				hooks.GET("", getWebhooks(db))
+				hooks.POST("", postWebhook(db))
+				hooks.POST("/:id/rotate", postWebhookRotate(db))
+				hooks.DELETE("/:id", delWebhook(db))
+				hooks.GET("/:id/deliveries", getDeliveries(db))
+				hooks.POST("/:id/replay", postReplay(db))
+
+				mod := admin.Group("", requireRole("moderator", "admin"))
+				mod.GET("/queue", getQueue(db))
+				mod.POST("/users/:id/suspend", postModerate(db, ring, modUser, "suspend", true))
+				mod.POST("/users/:id/unsuspend", postModerate(db, ring, modUser, "unsuspend", false))
+				mod.POST("/events/:id/hide", postModerate(db, ring, modEvent, "hide", true))
+				mod.POST("/events/:id/unhide", postModerate(db, ring, modEvent, "unhide", false))
+				mod.POST("/locs/:id/hide", postModerate(db, ring, modLocation, "hide", true))
+				mod.POST("/locs/:id/unhide", postModerate(db, ring, modLocation, "unhide", false))
+			}
+
 			user := v1.Group("users")
 			{
 				user.GET("", getUser(db))
 				user.POST("", postUser(db))
-				user.PUT("", putUser(db))
-				user.DELETE("", delUser(db))
+				user.PUT("", deprecated("/api/v1/users/{id}"), putUser(db))
+				user.DELETE("", deprecated("/api/v1/users/{id}"), delUser(db))
 
 				user.GET("/all", getUsers(db))
+
+				user.GET("/:id", getUserByID(db))
+				user.PUT("/:id", putUserByID(db))
+				user.PATCH("/:id", patchUser(db))
+				user.DELETE("/:id", delUserByID(db))
+				user.POST("/:id/report", postReport(db, "user"))
+				user.POST("/:id/follow", postFollow(db))
+				user.DELETE("/:id/follow", delRelation(db, "follow"))
+				user.POST("/:id/friend", postFriend(db))
+				user.DELETE("/:id/friend", delRelation(db, "friend"))
+				user.GET("/:id/followers", getRelations(db, "followers"))
+				user.GET("/:id/following", getRelations(db, "following"))
+				user.GET("/:id/friends", getRelations(db, "friends"))
+				user.GET("/:id/requests", getRelations(db, "requests"))
+				user.GET("/:id/notifications", getNotifications(db))
 			}
 			event := v1.Group("events")
 			{
-				event.GET("", getEvent(db))
+				event.GET("", deprecated("/api/v1/events/{id}"), getEvent(db))
 				event.POST("", postEvent(db))
-				event.PUT("", putEvent(db))
-				event.DELETE("", delEvent(db))
+				event.POST("/bulk", postEventsBulk(db, conf.Server.BulkMaxItems))
+				event.PUT("", deprecated("/api/v1/events/{id}"), putEvent(db))
+				event.DELETE("", deprecated("/api/v1/events/{id}"), delEvent(db))
 
 				event.GET("/all", getEvents(db))
+
+				event.GET("/:id", getEventByID(db))
+				event.PUT("/:id", putEventByID(db))
+				event.PATCH("/:id", patchEvent(db))
+				event.DELETE("/:id", delEventByID(db))
+				event.POST("/:id/report", postReport(db, "event"))
 			}
 			point := v1.Group("locs")
 			{
-				point.GET("", getLoc(db))
+				point.GET("", deprecated("/api/v1/locs/{id}"), getLoc(db))
 				point.POST("", postLoc(db))
-				point.PUT("", putLoc(db))
-				point.DELETE("", delLoc(db))
+				point.POST("/bulk", postLocsBulk(db, conf.Server.BulkMaxItems))
+				point.POST("/ingest", postIngest(ing))
+				point.PUT("", deprecated("/api/v1/locs/{id}"), putLoc(db))
+				point.DELETE("", deprecated("/api/v1/locs/{id}"), delLoc(db))
 
 				point.POST("/geoevent", postGeoEvent(db))
 
 				point.GET("/all", getLocs(db))
 				point.GET("/near", getNearLoc(db))
 				point.GET("/filter", getFiltered(db))
+				point.GET("/friends", getFriendsNear(db))
+
+				point.GET("/:id", getLocByID(db))
+				point.PUT("/:id", putLocByID(db))
+				point.PATCH("/:id", patchLoc(db))
+				point.DELETE("/:id", delLocByID(db))
+				point.POST("/:id/report", postReport(db, "location"))
 			}
 		}
 	}
 
-	router.NoRoute(returnPublic())
+	router.NoRoute(returnPublic(conf.StaticDir))
 
 	return router
 }
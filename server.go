@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"google.golang.org/grpc"
+)
+
+// ========== server
+
+// serve runs the api until SIGINT/SIGTERM, then drains requests and closes db
+func serve(conf *config, mongo *mongoDB) (err error) {
+	// request contexts derive from base and are cancelled when shutdown
+	// starts, so streaming handlers watching c.Request.Context() return,
+	// while plain handlers finish as usual
+	base, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the ingester outlives the requests so it can write what they queued
+	ingCtx, stopIngest := context.WithCancel(context.Background())
+	defer stopIngest()
+	ing := newIngester(mongo, &conf.Ingest)
+	// the subscribers of the change bus, and the oplog that feeds it
+	// rather than the writes of this instance
+	workers := []func(context.Context){}
+	if conf.Notify.Enabled {
+		workers = append(workers, newNotifier(mongo, &conf.Notify).run)
+	}
+	if conf.Webhooks.Enabled {
+		workers = append(workers, newDispatcher(mongo, &conf.Webhooks).run)
+	}
+	if conf.Mongo.Changes.Source == "oplog" {
+		workers = append(workers, func(ctx context.Context) { mongo.tailOplog(ctx, &conf.Mongo) })
+	}
+
+	// a revoke or a spent budget counts for both apis
+	ring := newKeyring(mongo, &conf.Auth)
+	limits := newLimitStore(&conf.RateLimit)
+
+	srv := &http.Server{
+		Addr:         conf.Listen,
+		Handler:      router(conf, mongo, ing, ring, limits),
+		ReadTimeout:  conf.Server.ReadTimeout,
+		WriteTimeout: conf.Server.WriteTimeout,
+		IdleTimeout:  conf.Server.IdleTimeout,
+		BaseContext: func(net.Listener) context.Context {
+			return base
+		},
+	}
+	srv.RegisterOnShutdown(cancel)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		mongo.keepAlive(base, &conf.Mongo)
+	}()
+	go func() {
+		defer wg.Done()
+		ing.run(ingCtx)
+	}()
+	for _, run := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			run(base)
+		}()
+	}
+	defer func() {
+		cancel()
+		stopIngest()
+		wg.Wait()
+		mongo.Session.Close()
+		slog.Info("db session closed")
+	}()
+
+	errc := make(chan error, 2)
+	go func() {
+		slog.Info("listen", "addr", conf.Listen)
+		errc <- srv.ListenAndServe()
+	}()
+
+	var grpcSrv *grpc.Server
+	if conf.GRPC.Listen != "" {
+		lis, err := net.Listen("tcp", conf.GRPC.Listen)
+		if err != nil {
+			srv.Close()
+			return err
+		}
+		grpcSrv = newGRPCServer(base, conf, mongo, ring, limits)
+		go func() {
+			slog.Info("listen grpc", "addr", conf.GRPC.Listen)
+			errc <- grpcSrv.Serve(lis)
+		}()
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	select {
+	case err = <-errc:
+		// one listener failed, don't keep serving half the api
+		srv.Close()
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+		return err
+	case s := <-sig:
+		slog.Info("shutting down", "signal", s.String())
+	}
+
+	ctx, stop := context.WithTimeout(context.Background(), conf.Server.ShutdownTimeout)
+	defer stop()
+	stopped := make(chan struct{})
+	if grpcSrv != nil {
+		// watch streams end on cancel, unary calls get to finish
+		cancel()
+		go func() {
+			grpcSrv.GracefulStop()
+			close(stopped)
+		}()
+	} else {
+		close(stopped)
+	}
+	err = srv.Shutdown(ctx)
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		// without grpc stopped is closed already and both cases are ready
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+	}
+	if err != nil {
+		srv.Close()
+		return err
+	}
+	slog.Info("all requests drained")
+	return nil
+}
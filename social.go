@@ -0,0 +1,149 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== social graph
+
+var errUserRequired = errUnauthorized("user_required",
+	"this route acts as a user, send an api key created with -user")
+
+// relatePair is the user of the request's key and the user of the path,
+// who must exist and not be the same
+func relatePair(c *gin.Context, mongo *mongoDB) (me, them bson.ObjectId, err error) {
+	them, err = pathID(c)
+	if err != nil {
+		return me, them, err
+	}
+	v := viewerFrom(c.Request.Context())
+	if v.User == "" {
+		return me, them, errUserRequired
+	}
+	if v.User == them {
+		return me, them, newAPIError(kindValidation, "self_relation",
+			"a user cannot follow or befriend itself", nil)
+	}
+	_, err = mongo.getUser(c.Request.Context(), &geoUser{ID: them})
+	return v.User, them, err
+}
+
+func postFollow(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		me, them, err := relatePair(c, mongo)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		rel, err := mongo.follow(c.Request.Context(), me, them)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "post follow complete", "body": rel})
+	}
+}
+
+// postFriend sends a friend request, or accepts the one the other user sent
+func postFriend(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		me, them, err := relatePair(c, mongo)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		rel, err := mongo.befriend(c.Request.Context(), me, them)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "post friend complete", "body": rel})
+	}
+}
+
+// delRelation unfollows, or cancels, declines or ends a friendship
+func delRelation(mongo *mongoDB, kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		them, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		me := viewerFrom(c.Request.Context()).User
+		if me == "" {
+			abortError(c, errUserRequired)
+			return
+		}
+
+		err = mongo.unrelate(c.Request.Context(), kind, me, them)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "del " + kind + " complete", "body": respondID{ID: them}})
+	}
+}
+
+// getRelations lists the followers, following, friends or the pending
+// friend requests of a user, only the user and staff see the requests
+func getRelations(mongo *mongoDB, list string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		var req reqRelations
+		err = c.ShouldBindQuery(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		if v := viewerFrom(c.Request.Context()); list == "requests" && v.User != id && !v.staff() {
+			abortError(c, errForbidden("not_yours", "only the user sees its friend requests"))
+			return
+		}
+
+		rels, err := mongo.getRelations(c.Request.Context(), list, id, &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get " + list + " complete", "body": rels})
+	}
+}
+
+// getFriendsNear is getNearLoc for the friends of the user of the key
+func getFriendsNear(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req reqNear
+		err := c.ShouldBindQuery(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		me := viewerFrom(c.Request.Context()).User
+		if me == "" {
+			abortError(c, errUserRequired)
+			return
+		}
+		req.TGeos = "Point"
+
+		locs, err := mongo.getFriendsNear(c.Request.Context(), me, &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get friends near complete", "body": locs})
+	}
+}
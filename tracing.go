@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== tracing
+
+var tracingExporters = []string{"none", "stdout", "otlp"}
+
+var tracer = otel.Tracer("github.com/asm-jaime/dvij.geoloc")
+
+// setTracing installs the global tracer provider, the returned func flushes it
+func setTracing(conf *tracingConfig) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	switch conf.Exporter {
+	case "stdout":
+		exporter, err = stdouttrace.New()
+	case "otlp":
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(conf.Endpoint)}
+		if conf.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(context.Background(), opts...)
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", conf.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// traceDB starts a span for a db method and records its metrics, use as
+// _, end := traceDB(ctx, "getUsers"); defer end(&err)
+func traceDB(ctx context.Context, op string) (context.Context, func(*error)) {
+	start := time.Now()
+	ctx, span := tracer.Start(ctx, "mongo."+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "mongodb"),
+			attribute.String("db.operation", op),
+		),
+	)
+	return ctx, func(err *error) {
+		observeDB(op, start, err)
+		if err != nil && *err != nil {
+			span.RecordError(*err)
+			span.SetStatus(codes.Error, (*err).Error())
+		}
+		span.End()
+	}
+}
+
+// tracePipeline adds an event per aggregation stage to the current span
+func tracePipeline(ctx context.Context, stages []bson.M) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	names := make([]string, 0, len(stages))
+	for i, stage := range stages {
+		for name, body := range stage {
+			names = append(names, name)
+			data, _ := json.Marshal(body)
+			span.AddEvent("pipeline stage", trace.WithAttributes(
+				attribute.Int("db.mongodb.stage.index", i),
+				attribute.String("db.mongodb.stage.name", name),
+				attribute.String("db.mongodb.stage.body", string(data)),
+			))
+		}
+	}
+	span.SetAttributes(attribute.StringSlice("db.mongodb.pipeline", names))
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ========== validation
+
+// fieldError reports one invalid request field
+type fieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+var setupValidationOnce sync.Once
+
+// setupValidation registers the custom rules used in model.go binding tags,
+// strict makes json binding reject unknown fields
+func setupValidation(strict bool) {
+	binding.EnableDecoderDisallowUnknownFields = strict
+	setupValidationOnce.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+		v.RegisterTagNameFunc(fieldName)
+		v.RegisterValidation("lnglat", validLngLat)
+		v.RegisterValidation("scope", validScope)
+		v.RegisterValidation("hookevent", validHookEvent)
+		v.RegisterValidation("publicurl", validPublicURL)
+	})
+}
+
+// validate runs the binding tags of model.go on a request that didn't come
+// through gin binding, like a grpc or graphql one
+func validate(obj interface{}) error {
+	err := binding.Validator.ValidateStruct(obj)
+	if err != nil {
+		return errValidation("invalid_request", err)
+	}
+	return nil
+}
+
+// fieldName reports fields by their request name rather than the go name
+func fieldName(f reflect.StructField) string {
+	for _, tag := range []string{"form", "json"} {
+		name := strings.Split(f.Tag.Get(tag), ",")[0]
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return strings.ToLower(f.Name)
+}
+
+// validLngLat checks a GeoJSON position [lng, lat]
+func validLngLat(fl validator.FieldLevel) bool {
+	pos, ok := fl.Field().Interface().([2]float64)
+	if !ok {
+		return false
+	}
+	return pos[0] >= -180 && pos[0] <= 180 && pos[1] >= -90 && pos[1] <= 90
+}
+
+// validScope checks an api key scope against apiScopes
+func validScope(fl validator.FieldLevel) bool {
+	return contains(apiScopes, fl.Field().String())
+}
+
+// validHookEvent checks an event type a webhook subscribes to
+func validHookEvent(fl validator.FieldLevel) bool {
+	return hookEventValid(fl.Field().String())
+}
+
+// validPublicURL checks a url the server posts to is not on its network
+func validPublicURL(fl validator.FieldLevel) bool {
+	return publicURL(fl.Field().String())
+}
+
+// fieldErrors turns binding errors into per-field reports
+func fieldErrors(err error) (fields []fieldError) {
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		for _, fe := range verrs {
+			fields = append(fields, fieldError{
+				Field:   trimNamespace(fe.Namespace()),
+				Rule:    fe.Tag(),
+				Message: ruleMessage(fe),
+			})
+		}
+		return fields
+	}
+
+	var terr *json.UnmarshalTypeError
+	if errors.As(err, &terr) {
+		return []fieldError{{
+			Field:   terr.Field,
+			Rule:    "type",
+			Message: "must be " + terr.Type.String(),
+		}}
+	}
+
+	// encoding/json has no typed error for DisallowUnknownFields
+	if msg := err.Error(); strings.HasPrefix(msg, "json: unknown field ") {
+		return []fieldError{{
+			Field:   strings.Trim(strings.TrimPrefix(msg, "json: unknown field "), `"`),
+			Rule:    "unknown",
+			Message: "is not a known field",
+		}}
+	}
+	return nil
+}
+
+// trimNamespace drops the struct name, geoLocation.location.type -> location.type
+func trimNamespace(ns string) string {
+	if i := strings.Index(ns, "."); i >= 0 {
+		return ns[i+1:]
+	}
+	return ns
+}
+
+func ruleMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "oneof":
+		return "must be one of: " + strings.Join(strings.Fields(fe.Param()), ", ")
+	case "email":
+		return "must be a valid email"
+	case "lnglat":
+		return "must be [lng, lat] with lng in [-180,180] and lat in [-90,90]"
+	case "scope":
+		return "must be one of: " + strings.Join(apiScopes, ", ")
+	case "cidr|ip":
+		return "must be an ip or a cidr"
+	case "gt":
+		return "must be greater than " + fe.Param()
+	case "ltfield":
+		return "must be less than " + fe.Param()
+	case "min", "gte":
+		return "must be at least " + fe.Param() + sizeUnit(fe.Kind())
+	case "max", "lte":
+		return "must be at most " + fe.Param() + sizeUnit(fe.Kind())
+	}
+	return fmt.Sprintf("failed rule %s", fe.Tag())
+}
+
+func sizeUnit(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return " items"
+	}
+	return ""
+}
@@ -9,8 +9,7 @@ export const EVENTS = {
 export const LOCS = {
   LOC: `${process.env.REACT_APP_API_URL}/locs`,
   ALL: `${process.env.REACT_APP_API_URL}/locs/all`,
-  RND: `${process.env.REACT_APP_API_URL}/locs/rnd`,
+  GEOEVENT: `${process.env.REACT_APP_API_URL}/locs/geoevent`,
   NEAR: `${process.env.REACT_APP_API_URL}/locs/near`,
-  STATE: `${process.env.REACT_APP_API_URL}/locs/state`,
   FILTER: `${process.env.REACT_APP_API_URL}/locs/filter`,
 };
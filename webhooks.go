@@ -0,0 +1,438 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ========== webhooks
+
+// hookEventValid checks a type a webhook subscribes to, "*", "<kind>.*"
+// or "<kind>.<action>"
+func hookEventValid(t string) bool {
+	if t == "*" {
+		return true
+	}
+	kind, action, ok := strings.Cut(t, ".")
+	if !ok || !contains(changeKinds, kind) {
+		return false
+	}
+	if action == "*" {
+		return true
+	}
+	for _, past := range changeActions {
+		if past == action {
+			return true
+		}
+	}
+	return false
+}
+
+// hookEvent is the body of a delivery, ID is the change so a receiver can
+// tell a replay from a new event. Changes from the oplog have no actor.
+type hookEvent struct {
+	ID        bson.ObjectId `json:"id"`
+	Type      string        `json:"type"`
+	At        time.Time     `json:"occurred_at"`
+	Entity    bson.ObjectId `json:"entity"`
+	Actor     string        `json:"actor,omitempty"`
+	RequestID string        `json:"request_id,omitempty"`
+	Data      bson.M        `json:"data,omitempty"`
+	Changes   []auditChange `json:"changes,omitempty"`
+}
+
+// hookSubject is where a written user, event or location is and its tags,
+// a location has the tags of what it locates
+type hookSubject struct {
+	Loc  *geoObject
+	Tags []string
+}
+
+// wants tells if a write to s is in the area and has one of the tags of h
+func (h *webhook) wants(s hookSubject) bool {
+	if h.Area != nil && (s.Loc == nil || !h.Area.bbox().holds(*s.Loc)) {
+		return false
+	}
+	return len(h.Tags) == 0 || overlaps(h.Tags, s.Tags)
+}
+
+func (a *hookArea) bbox() reqBBox {
+	return reqBBox{MinLng: a.MinLng, MinLat: a.MinLat, MaxLng: a.MaxLng, MaxLat: a.MaxLat}
+}
+
+// signHook is the X-Geoloc-Signature of body sent at t: t=<unix>,v1=<hex
+// hmac-sha256 of "<unix>.<body>" under the secret>. The time is signed so
+// receivers can refuse old requests played again.
+func signHook(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newHookSecret is a random signing secret, whsec_<hex>
+func newHookSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// createWebhook stores a subscription for req, the answer holds its secret
+func createWebhook(ctx context.Context, mongo *mongoDB, req *reqWebhook, now time.Time) (res webhookSecret, err error) {
+	secret, err := newHookSecret()
+	if err != nil {
+		return res, err
+	}
+	res.webhook = webhook{
+		ID:          bson.NewObjectId(),
+		URL:         req.URL,
+		Description: req.Description,
+		Secret:      secret,
+		Events:      req.Events,
+		Area:        req.Area,
+		Tags:        req.Tags,
+		CreatedAt:   now,
+	}
+	res.Secret = secret
+	err = mongo.postWebhook(ctx, &res.webhook)
+	return res, err
+}
+
+// rotateWebhook gives a webhook a new secret, deliveries are signed with
+// it from the next attempt on
+func rotateWebhook(ctx context.Context, mongo *mongoDB, id bson.ObjectId, now time.Time) (res webhookSecret, err error) {
+	secret, err := newHookSecret()
+	if err != nil {
+		return res, err
+	}
+	res.webhook, err = mongo.rotateWebhook(ctx, id, secret, now)
+	res.Secret = secret
+	return res, err
+}
+
+// ========== dispatcher
+
+// dispatcher matches the changes of the bus with the webhooks into
+// deliveries and sends them
+type dispatcher struct {
+	mongo  *mongoDB
+	conf   *webhookConfig
+	client *http.Client
+}
+
+func newDispatcher(mongo *mongoDB, conf *webhookConfig) *dispatcher {
+	return &dispatcher{
+		mongo:  mongo,
+		conf:   conf,
+		client: &http.Client{Timeout: conf.Timeout},
+	}
+}
+
+// run matches changes and polls the deliveries until ctx is done. The bus
+// keeps QueueSize changes for it, when the matcher falls behind more are
+// dropped.
+func (d *dispatcher) run(ctx context.Context) {
+	unsubscribe := d.mongo.bus.subscribe(ctx, "webhooks", d.conf.QueueSize, func(ctx context.Context, c change) {
+		err := d.match(ctx, c)
+		if err != nil {
+			slog.Error("webhook match", "error", err.Error(), "change", c.Type, "entity", c.Entity.Hex())
+		}
+	})
+	defer unsubscribe()
+
+	ticker := time.NewTicker(d.conf.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.deliver(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// match adds a delivery of c for every webhook that wants it, where the
+// entity is and its tags are only read for webhooks that filter on them
+func (d *dispatcher) match(ctx context.Context, c change) error {
+	hooks, err := d.mongo.getWebhooksFor(ctx, c.Kind, c.Type)
+	if err != nil || len(hooks) == 0 {
+		return err
+	}
+	body, err := json.Marshal(hookEvent{
+		ID:        c.ID,
+		Type:      c.Type,
+		At:        c.At,
+		Entity:    c.Entity,
+		Actor:     c.Actor,
+		RequestID: c.RequestID,
+		Data:      c.doc(),
+		Changes:   diffDocs(c.Before, c.After),
+	})
+	if err != nil {
+		return err
+	}
+
+	var subject *hookSubject
+	now := time.Now()
+	dels := []delivery{}
+	for i := range hooks {
+		h := &hooks[i]
+		if h.Area != nil || len(h.Tags) > 0 {
+			if subject == nil {
+				s, err := d.mongo.hookSubject(ctx, c)
+				if err != nil {
+					return err
+				}
+				subject = &s
+			}
+			if !h.wants(*subject) {
+				continue
+			}
+		}
+		dels = append(dels, delivery{
+			ID:        bson.NewObjectId(),
+			Hook:      h.ID,
+			Event:     c.ID,
+			Type:      c.Type,
+			Entity:    c.Entity,
+			Body:      string(body),
+			Status:    "pending",
+			NextAt:    now,
+			CreatedAt: now,
+		})
+	}
+	if len(dels) == 0 {
+		return nil
+	}
+	return d.mongo.postDeliveries(ctx, dels)
+}
+
+// deliver sends up to BatchSize due deliveries one by one
+func (d *dispatcher) deliver(ctx context.Context) {
+	for i := 0; i < d.conf.BatchSize && ctx.Err() == nil; i++ {
+		dl, err := d.mongo.claimDelivery(ctx, time.Now(), d.conf.Lease)
+		if err == mgo.ErrNotFound {
+			return
+		}
+		if err != nil {
+			slog.Error("webhook claim", "error", err.Error())
+			return
+		}
+		d.send(ctx, &dl)
+	}
+}
+
+// send posts a claimed delivery and records how it went, after
+// MaxAttempts failures it is dead until an admin replays it
+func (d *dispatcher) send(ctx context.Context, dl *delivery) {
+	hook, err := d.mongo.getWebhook(ctx, dl.Hook)
+	if err == mgo.ErrNotFound {
+		d.finish(ctx, dl, "dead", bson.M{"last_error": "webhook deleted"})
+		return
+	}
+	if err != nil {
+		// the lease runs out and it is claimed again
+		slog.Error("webhook read", "error", err.Error(), "delivery", dl.ID.Hex())
+		return
+	}
+
+	status, err := d.post(ctx, &hook, dl, time.Now())
+	attempts := dl.Attempts + 1
+	if err == nil {
+		d.finish(ctx, dl, "delivered", bson.M{
+			"attempts": attempts, "last_status": status, "delivered_at": time.Now(),
+		})
+		return
+	}
+	set := bson.M{"attempts": attempts, "last_status": status, "last_error": err.Error()}
+	if attempts >= d.conf.MaxAttempts {
+		d.finish(ctx, dl, "dead", set)
+		return
+	}
+	set["next_at"] = time.Now().Add(backoff(d.conf.BackoffMin, d.conf.BackoffMax, attempts))
+	d.finish(ctx, dl, "pending", set)
+}
+
+// post sends the body of dl to hook signed at now, status is the answer's
+// or 0 when there is none
+func (d *dispatcher) post(ctx context.Context, hook *webhook, dl *delivery, now time.Time) (status int, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, strings.NewReader(dl.Body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "geoloc-webhooks")
+	req.Header.Set("X-Geoloc-Event", dl.Type)
+	req.Header.Set("X-Geoloc-Delivery", dl.ID.Hex())
+	req.Header.Set("X-Geoloc-Signature", signHook(hook.Secret, now, []byte(dl.Body)))
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("webhook answered %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+func (d *dispatcher) finish(ctx context.Context, dl *delivery, status string, set bson.M) {
+	result := status
+	if status == "pending" {
+		result = "retried"
+	}
+	metrics.webhooks.WithLabelValues(result).Inc()
+	set["status"] = status
+	err := d.mongo.updateDelivery(ctx, dl.ID, set)
+	if err != nil {
+		slog.Error("webhook finish", "error", err.Error(), "delivery", dl.ID.Hex())
+	}
+}
+
+// ========== webhook admin api
+
+func getWebhooks(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hooks, err := mongo.getWebhooks(c.Request.Context())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get webhooks complete", "body": hooks})
+	}
+}
+
+func postWebhook(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req reqWebhook
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		res, err := createWebhook(c.Request.Context(), mongo, &req, time.Now())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "post webhook complete", "body": res})
+	}
+}
+
+func postWebhookRotate(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		res, err := rotateWebhook(c.Request.Context(), mongo, id, time.Now())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "rotate webhook complete", "body": res})
+	}
+}
+
+// delWebhook removes a webhook with its deliveries
+func delWebhook(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		hook, err := mongo.delWebhook(c.Request.Context(), id)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "del webhook complete", "body": hook})
+	}
+}
+
+// getDeliveries lists the deliveries of a webhook, status=dead is its dead
+// letter list
+func getDeliveries(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		var req reqDeliveries
+		err = c.ShouldBindQuery(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+
+		dels, err := mongo.getDeliveries(c.Request.Context(), id, &req)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": "get deliveries complete", "body": dels})
+	}
+}
+
+// postReplay sends the dead deliveries of a webhook again, or the one
+// given whatever became of it
+func postReplay(mongo *mongoDB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := pathID(c)
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		var req reqReplay
+		err = c.ShouldBindQuery(&req)
+		if err != nil {
+			abortError(c, errValidation("invalid_request", err))
+			return
+		}
+		var one bson.ObjectId
+		if req.Delivery != "" {
+			one = bson.ObjectIdHex(req.Delivery)
+		}
+
+		n, err := mongo.replayDeliveries(c.Request.Context(), id, one, time.Now())
+		if err != nil {
+			abortError(c, err)
+			return
+		}
+		metrics.webhooks.WithLabelValues("replayed").Add(float64(n))
+
+		c.JSON(http.StatusOK, gin.H{"msg": "replay complete", "body": gin.H{"replayed": n}})
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestWebhookEvents(t *testing.T) {
+	assert.Equal(t, "location.created", changeType("location", "create"))
+	assert.Equal(t, "user.role_changed", changeType("user", "role"))
+	assert.Equal(t, "event.hidden", changeType("event", "hide"))
+
+	for typ, ok := range map[string]bool{
+		"*": true, "user.*": true, "event.updated": true, "location.deleted": true,
+		"": false, "user": false, "report.created": false, "event.exploded": false,
+	} {
+		assert.Equal(t, ok, hookEventValid(typ), typ)
+	}
+
+	setupValidation(false)
+	err := validate(&reqWebhook{URL: "not a url", Events: []string{"user.*", "user.born"},
+		Area: &hookArea{MinLng: 10, MaxLng: 5, MaxLat: 1}})
+	assert.Error(t, err)
+	for _, f := range []string{"url", "events[1]", "minlng"} {
+		assert.Contains(t, strings.ToLower(err.Error()), f)
+	}
+	assert.NoError(t, validate(&reqWebhook{URL: "https://example.com/hook", Events: []string{"location.*"}}))
+}
+
+func TestWebhookFilter(t *testing.T) {
+	paris := &geoObject{Type: "Point", Coordinates: [2]float64{2.3522, 48.8566}}
+	france := &hookArea{MinLng: -5, MinLat: 42, MaxLng: 8, MaxLat: 51}
+	for _, c := range []struct {
+		hook webhook
+		s    hookSubject
+		want bool
+	}{
+		{webhook{}, hookSubject{}, true},
+		{webhook{Area: france}, hookSubject{Loc: paris}, true},
+		{webhook{Area: france}, hookSubject{}, false},
+		{webhook{Area: france}, hookSubject{Loc: &geoObject{Coordinates: [2]float64{13.4, 52.5}}}, false},
+		{webhook{Tags: []string{"music"}}, hookSubject{Tags: []string{"art", "music"}}, true},
+		{webhook{Tags: []string{"music"}}, hookSubject{Tags: []string{"art"}}, false},
+		{webhook{Area: france, Tags: []string{"music"}}, hookSubject{Loc: paris}, false},
+	} {
+		assert.Equal(t, c.want, c.hook.wants(c.s), "%+v %+v", c.hook, c.s)
+	}
+}
+
+func TestWebhookPost(t *testing.T) {
+	secret, err := newHookSecret()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, "whsec_"))
+	hook := &webhook{ID: bson.NewObjectId(), Secret: secret}
+	dl := &delivery{ID: bson.NewObjectId(), Type: "event.created", Body: `{"type":"event.created"}`}
+	now := time.Unix(1700000000, 0)
+
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, dl.Body, string(body))
+		assert.Equal(t, "event.created", r.Header.Get("X-Geoloc-Event"))
+		assert.Equal(t, dl.ID.Hex(), r.Header.Get("X-Geoloc-Delivery"))
+
+		// the receiver side of the signature
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte("1700000000." + string(body)))
+		want := "t=1700000000,v1=" + hex.EncodeToString(mac.Sum(nil))
+		assert.Equal(t, want, r.Header.Get("X-Geoloc-Signature"))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	hook.URL = srv.URL
+
+	conf := defaultConfig().Webhooks
+	d := newDispatcher(&mongoDB{}, &conf)
+	code, err := d.post(context.Background(), hook, dl, now)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+	status = http.StatusServiceUnavailable
+	code, err = d.post(context.Background(), hook, dl, now)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}